@@ -0,0 +1,44 @@
+package money
+
+// Accumulator keeps a running total of amounts. The zero value is an empty accumulator ready to use.
+type Accumulator struct {
+	total Micro
+	count int
+}
+
+// Add adds m to the total. On overflow it returns ErrOverflow and leaves the accumulator unchanged.
+func (a *Accumulator) Add(m Micro) error {
+	total, err := Add(a.total, m)
+	if err != nil {
+		return err
+	}
+
+	a.total = total
+	a.count++
+	return nil
+}
+
+func (a *Accumulator) Total() Micro {
+	return a.total
+}
+
+// Count returns the number of amounts that were successfully added.
+func (a *Accumulator) Count() int {
+	return a.count
+}
+
+// SumBy groups items by the given key function and returns the total of every group. If any total overflows,
+// it returns ErrOverflow.
+func SumBy[K comparable](items []Micro, key func(Micro) K) (map[K]Micro, error) {
+	totals := make(map[K]Micro)
+	for _, item := range items {
+		k := key(item)
+
+		total, err := Add(totals[k], item)
+		if err != nil {
+			return nil, err
+		}
+		totals[k] = total
+	}
+	return totals, nil
+}
@@ -0,0 +1,55 @@
+package money
+
+func (suite *MoneyTestSuite) TestAccumulator() {
+	var a Accumulator
+	suite.Equal(Micro(0), a.Total())
+	suite.Equal(0, a.Count())
+
+	for _, m := range []Micro{801 * Cent, -1 * Dollar, 0, 199 * Cent} {
+		suite.Nil(a.Add(m))
+	}
+	suite.Equal(9*Dollar, a.Total())
+	suite.Equal(4, a.Count())
+}
+
+func (suite *MoneyTestSuite) TestAccumulatorOverflow() {
+	var a Accumulator
+	suite.Nil(a.Add(MaxMicro))
+	suite.Nil(a.Add(-1))
+
+	err := a.Add(2)
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(MaxMicro-1), a.Total())
+	suite.Equal(2, a.Count())
+
+	suite.Nil(a.Add(1))
+	suite.Equal(Micro(MaxMicro), a.Total())
+	suite.Equal(3, a.Count())
+}
+
+func (suite *MoneyTestSuite) TestSumBy() {
+	sign := func(m Micro) string {
+		if m < 0 {
+			return "debit"
+		}
+		return "credit"
+	}
+
+	totals, err := SumBy([]Micro{801 * Cent, -1 * Dollar, 0, 199 * Cent, -50 * Cent}, sign)
+	suite.Nil(err)
+	suite.Equal(map[string]Micro{"credit": 10 * Dollar, "debit": -150 * Cent}, totals)
+
+	// any comparable key works
+	totals2, err := SumBy([]Micro{1, 2, 3, 4, 5}, func(m Micro) bool { return m%2 == 0 })
+	suite.Nil(err)
+	suite.Equal(map[bool]Micro{true: 6, false: 9}, totals2)
+
+	totals, err = SumBy(nil, sign)
+	suite.Nil(err)
+	suite.Equal(map[string]Micro{}, totals)
+
+	// only the credit group overflows, the total of all items would fit
+	totals, err = SumBy([]Micro{MaxMicro, -1 * Dollar, 1 * Dollar}, sign)
+	suite.Equal(ErrOverflow, err)
+	suite.Nil(totals)
+}
@@ -0,0 +1,73 @@
+package money
+
+import (
+	"math/big"
+)
+
+// allocateProportionally splits amount into shares proportional to weights that sum exactly to amount. Every
+// share is the difference of two rounded cumulative shares, so it's within a micro of its exact value and no
+// remainder is left over. The weights must not sum to zero.
+func allocateProportionally(amount Micro, weights []Micro) ([]Micro, error) {
+	total := new(big.Int)
+	for _, weight := range weights {
+		total.Add(total, big.NewInt(int64(weight)))
+	}
+	if total.Sign() == 0 {
+		return nil, ErrZeroDivision
+	}
+
+	result := make([]Micro, len(weights))
+	cumulative := new(big.Int)
+	previous := Micro(0)
+	for i, weight := range weights {
+		cumulative.Add(cumulative, big.NewInt(int64(weight)))
+
+		product := new(big.Int).Mul(big.NewInt(int64(amount)), cumulative)
+		allocated, err := divideBigAndRound(product, total, RoundingHalfAwayFromZero)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = allocated - previous
+		previous = allocated
+	}
+	return result, nil
+}
+
+// ApplyDiscount takes discountPercent percent, eg. 10*Dollar for 10%, off the total of lineItems and spreads
+// the discount over the lines in proportion to their amounts. Unlike discounting every line on its own, the
+// discounted lines always add up exactly to the discounted total. The discount is rounded half away from zero.
+func ApplyDiscount(lineItems []Micro, discountPercent Micro) ([]Micro, error) {
+	if discountPercent < 0 || discountPercent > 100*Dollar {
+		return nil, ErrInvalidInput
+	}
+
+	total := Micro(0)
+	for _, line := range lineItems {
+		var err error
+		total, err = Add(total, line)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	discount, err := MulDiv(total, int64(discountPercent), int64(100*Dollar), RoundingHalfAwayFromZero)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Micro, len(lineItems))
+	copy(result, lineItems)
+	if discount == 0 {
+		return result, nil
+	}
+
+	discounts, err := allocateProportionally(discount, lineItems)
+	if err != nil {
+		return nil, err
+	}
+	for i := range result {
+		result[i] -= discounts[i]
+	}
+	return result, nil
+}
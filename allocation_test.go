@@ -0,0 +1,84 @@
+package money
+
+import (
+	"fmt"
+)
+
+func (suite *MoneyTestSuite) TestAllocateProportionally() {
+	result, err := allocateProportionally(100*Cent, []Micro{1, 1, 1})
+	suite.Nil(err)
+	suite.Equal([]Micro{333333, 333334, 333333}, result)
+
+	result, err = allocateProportionally(-100*Cent, []Micro{1, 1, 1})
+	suite.Nil(err)
+	suite.Equal([]Micro{-333333, -333334, -333333}, result)
+
+	result, err = allocateProportionally(10*Dollar, []Micro{3 * Dollar, Dollar, 0})
+	suite.Nil(err)
+	suite.Equal([]Micro{750 * Cent, 250 * Cent, 0}, result)
+
+	result, err = allocateProportionally(10*Dollar, []Micro{Dollar, -Dollar})
+	suite.Equal(ErrZeroDivision, err)
+	suite.Nil(result)
+}
+
+func (suite *MoneyTestSuite) TestApplyDiscount() {
+	// 10% of 1.00 + 2.00 + 0.07 is 0.307, which doesn't split evenly in cents
+	lines := []Micro{100 * Cent, 200 * Cent, 7 * Cent}
+	result, err := ApplyDiscount(lines, 10*Dollar)
+	suite.Nil(err)
+	suite.Equal([]Micro{900000, 1800000, 63000}, result)
+	suite.Equal([]Micro{100 * Cent, 200 * Cent, 7 * Cent}, lines)
+
+	lines = []Micro{333333, 333333, 333333}
+	result, err = ApplyDiscount(lines, 10*Dollar)
+	suite.Nil(err)
+	suite.Equal([]Micro{300000, 299999, 300000}, result)
+
+	result, err = ApplyDiscount([]Micro{5 * Dollar, 5 * Dollar}, 0)
+	suite.Nil(err)
+	suite.Equal([]Micro{5 * Dollar, 5 * Dollar}, result)
+
+	result, err = ApplyDiscount([]Micro{5 * Dollar, 5 * Dollar}, 100*Dollar)
+	suite.Nil(err)
+	suite.Equal([]Micro{0, 0}, result)
+
+	result, err = ApplyDiscount([]Micro{}, 10*Dollar)
+	suite.Nil(err)
+	suite.Equal([]Micro{}, result)
+
+	result, err = ApplyDiscount([]Micro{5 * Dollar}, -Dollar)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Nil(result)
+
+	result, err = ApplyDiscount([]Micro{5 * Dollar}, 101*Dollar)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Nil(result)
+
+	result, err = ApplyDiscount([]Micro{MaxMicro, 1}, 10*Dollar)
+	suite.Equal(ErrOverflow, err)
+	suite.Nil(result)
+}
+
+func (suite *MoneyTestSuite) TestApplyDiscountSum() {
+	percents := []Micro{MustFromString("0.5"), 10 * Dollar, MustFromString("12.345"), 33 * Dollar, 99 * Dollar}
+	lines := []Micro{1234567, 7 * Cent, 19999 * Cent, 1, 3 * Dollar, 555555}
+	total := Micro(0)
+	for _, line := range lines {
+		total += line
+	}
+
+	for _, percent := range percents {
+		result, err := ApplyDiscount(lines, percent)
+		suite.Nil(err)
+
+		discount, err := MulDiv(total, int64(percent), int64(100*Dollar), RoundingHalfAwayFromZero)
+		suite.Nil(err)
+
+		sum := Micro(0)
+		for _, line := range result {
+			sum += line
+		}
+		suite.Equal(total-discount, sum, fmt.Sprintf("Input: %d", percent))
+	}
+}
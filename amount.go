@@ -0,0 +1,71 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Decimals is the constraint of Amount's scale type parameter. Its DecimalPlaces method is called on the zero
+// value, so scales are empty marker types like Two, Six and Nine. It has to return 0 to 18, the decimal places
+// an int64 can hold, or Amount's functions and methods panic.
+type Decimals interface {
+	DecimalPlaces() int64
+}
+
+// Two is the scale of cent amounts.
+type Two struct{}
+
+// Six is the scale of Micro.
+type Six struct{}
+
+// Nine is the scale of Nano.
+type Nine struct{}
+
+func (Two) DecimalPlaces() int64  { return 2 }
+func (Six) DecimalPlaces() int64  { return precisionExp }
+func (Nine) DecimalPlaces() int64 { return nanoPrecisionExp }
+
+// Amount is a fixed-point amount with the number of decimal places given by S. Amount[Six] has the same
+// representation as Micro, so the two convert to each other with a plain type conversion.
+type Amount[S Decimals] int64
+
+// amountDecimalPlaces returns the number of decimal places of S and 10 to the power of it.
+func amountDecimalPlaces[S Decimals]() (int64, int64) {
+	var scale S
+	decimalPlaces := scale.DecimalPlaces()
+	// 10^19 doesn't fit into int64, so the unit would silently overflow
+	if decimalPlaces < 0 || decimalPlaces > 18 {
+		panic(fmt.Sprintf("money: unsupported number of decimal places %d of %T", decimalPlaces, scale))
+	}
+
+	unit := int64(1)
+	for i := int64(0); i < decimalPlaces; i++ {
+		unit *= 10
+	}
+	return decimalPlaces, unit
+}
+
+// ParseAmount is FromString for amounts of any scale.
+func ParseAmount[S Decimals](amount string) (Amount[S], error) {
+	decimalPlaces, _ := amountDecimalPlaces[S]()
+	result, _, err := parseDecimal(strings.TrimSpace(amount), decimalPlaces)
+	return Amount[S](result), err
+}
+
+// Add returns a + b, or ErrOverflow when the sum doesn't fit.
+func (a Amount[S]) Add(b Amount[S]) (Amount[S], error) {
+	result, err := Add(Micro(a), Micro(b))
+	return Amount[S](result), err
+}
+
+// Sub returns a - b, or ErrOverflow when the difference doesn't fit.
+func (a Amount[S]) Sub(b Amount[S]) (Amount[S], error) {
+	result, err := Sub(Micro(a), Micro(b))
+	return Amount[S](result), err
+}
+
+// String formats the amount like ToString, without trailing zeros.
+func (a Amount[S]) String() string {
+	decimalPlaces, unit := amountDecimalPlaces[S]()
+	return string(appendDecimal(make([]byte, 0, 24), int64(a), decimalPlaces, unit))
+}
@@ -0,0 +1,102 @@
+package money
+
+import (
+	"fmt"
+	"math"
+)
+
+func (suite *MoneyTestSuite) TestAmountCents() {
+	a, err := ParseAmount[Two]("8.01")
+	suite.Nil(err)
+	suite.Equal(Amount[Two](801), a)
+	suite.Equal("8.01", a.String())
+
+	// digits beyond the scale are rounded half away from zero, as with FromString
+	b, err := ParseAmount[Two]("-0.005")
+	suite.Nil(err)
+	suite.Equal(Amount[Two](-1), b)
+	suite.Equal("-0.01", b.String())
+
+	sum, err := a.Add(b)
+	suite.Nil(err)
+	suite.Equal("8", sum.String())
+
+	difference, err := a.Sub(b)
+	suite.Nil(err)
+	suite.Equal("8.02", difference.String())
+
+	_, err = Amount[Two](math.MaxInt64).Add(1)
+	suite.Equal(ErrOverflow, err)
+
+	_, err = ParseAmount[Two]("8.01x")
+	suite.Equal(ErrInvalidInput, err)
+
+	// surrounding whitespace is ignored, as with FromString
+	c, err := ParseAmount[Two](" 8.01\n")
+	suite.Nil(err)
+	suite.Equal(a, c)
+
+	_, err = ParseAmount[Two]("\t")
+	suite.Equal(ErrEmptyInput, err)
+}
+
+// the largest scale an int64 can hold and two that it can't
+type eighteen struct{}
+type nineteen struct{}
+type negative struct{}
+
+func (eighteen) DecimalPlaces() int64 { return 18 }
+func (nineteen) DecimalPlaces() int64 { return 19 }
+func (negative) DecimalPlaces() int64 { return -1 }
+
+func (suite *MoneyTestSuite) TestAmountScaleBounds() {
+	a, err := ParseAmount[eighteen]("-1.000000000000000001")
+	suite.Nil(err)
+	suite.Equal(Amount[eighteen](-1000000000000000001), a)
+	suite.Equal("-1.000000000000000001", a.String())
+
+	suite.PanicsWithValue("money: unsupported number of decimal places 19 of money.nineteen", func() {
+		_, _ = ParseAmount[nineteen]("1")
+	})
+	suite.Panics(func() {
+		_ = Amount[nineteen](1).String()
+	})
+	suite.Panics(func() {
+		_ = Amount[negative](1).String()
+	})
+}
+
+func (suite *MoneyTestSuite) TestAmountNano() {
+	a, err := ParseAmount[Nine]("0.123456789")
+	suite.Nil(err)
+	suite.Equal(Amount[Nine](123456789), a)
+	suite.Equal("0.123456789", a.String())
+
+	sum, err := a.Add(a)
+	suite.Nil(err)
+	suite.Equal("0.246913578", sum.String())
+
+	difference, err := a.Sub(Amount[Nine](nanoPrecision))
+	suite.Nil(err)
+	suite.Equal("-0.876543211", difference.String())
+
+	_, err = Amount[Nine](math.MinInt64).Sub(1)
+	suite.Equal(ErrOverflow, err)
+}
+
+func (suite *MoneyTestSuite) TestAmountMatchesMicro() {
+	for _, input := range []string{"0", "8.01", "-0.000001", "123.7645385", "9223372036854.775807"} {
+		micro, err := FromString(input)
+		suite.Nil(err)
+
+		amount, err := ParseAmount[Six](input)
+		suite.Nil(err)
+
+		suite.Equal(micro, Micro(amount), fmt.Sprintf("Input: %s", input))
+		suite.Equal(ToString(micro), amount.String(), fmt.Sprintf("Input: %s", input))
+	}
+
+	nano, err := FromStringNano("-1.000000001")
+	suite.Nil(err)
+	suite.Equal(ToStringNano(nano), Amount[Nine](nano).String())
+}
@@ -0,0 +1,120 @@
+package money
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is a fixed-point number with its own number of decimal places (scale), for values that need more
+// or less precision than Micro. Its value is unscaled / 10^scale.
+type Decimal struct {
+	value int64
+	scale uint8
+}
+
+func NewDecimal(unscaled int64, scale uint8) Decimal {
+	return Decimal{value: unscaled, scale: scale}
+}
+
+func (d Decimal) Unscaled() int64 {
+	return d.value
+}
+
+func (d Decimal) Scale() uint8 {
+	return d.scale
+}
+
+// Add returns d + other with the larger of the two scales.
+func (d Decimal) Add(other Decimal) (Decimal, error) {
+	a, b, scale, err := alignDecimals(d, other)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	result, err := Add(Micro(a), Micro(b))
+	if err != nil {
+		return Decimal{}, err
+	}
+	return Decimal{value: int64(result), scale: scale}, nil
+}
+
+// Sub returns d - other with the larger of the two scales.
+func (d Decimal) Sub(other Decimal) (Decimal, error) {
+	a, b, scale, err := alignDecimals(d, other)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	result, err := Sub(Micro(a), Micro(b))
+	if err != nil {
+		return Decimal{}, err
+	}
+	return Decimal{value: int64(result), scale: scale}, nil
+}
+
+// String formats the decimal with all of its decimal places, eg. "1.50" for a scale of 2.
+func (d Decimal) String() string {
+	digits := strconv.FormatUint(uint64(d.value), 10)
+	if d.value < 0 {
+		digits = strconv.FormatUint(uint64(-d.value), 10)
+	}
+
+	if d.scale > 0 {
+		if len(digits) <= int(d.scale) {
+			digits = strings.Repeat("0", int(d.scale)-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-int(d.scale)] + "." + digits[len(digits)-int(d.scale):]
+	}
+
+	if d.value < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+func (micro Micro) ToDecimal() Decimal {
+	return Decimal{value: int64(micro), scale: uint8(precisionExp)}
+}
+
+// FromDecimal converts d to Micro, rounding half away from zero when d has more than six decimal places.
+func FromDecimal(d Decimal) (Micro, error) {
+	result, err := rescaleDecimal(d.value, d.scale, uint8(precisionExp), RoundingHalfAwayFromZero)
+	return Micro(result), err
+}
+
+func alignDecimals(a Decimal, b Decimal) (int64, int64, uint8, error) {
+	scale := a.scale
+	if b.scale > scale {
+		scale = b.scale
+	}
+
+	aValue, err := rescaleDecimal(a.value, a.scale, scale, RoundingNone)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	bValue, err := rescaleDecimal(b.value, b.scale, scale, RoundingNone)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return aValue, bValue, scale, nil
+}
+
+func rescaleDecimal(value int64, from uint8, to uint8, rounding byte) (int64, error) {
+	if from == to {
+		return value, nil
+	}
+
+	if to > from {
+		factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(to-from)), nil)
+		result := factor.Mul(factor, big.NewInt(value))
+		if !result.IsInt64() {
+			return 0, ErrOverflow
+		}
+		return result.Int64(), nil
+	}
+
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(from-to)), nil)
+	result, err := divideBigAndRound(big.NewInt(value), factor, rounding)
+	return int64(result), err
+}
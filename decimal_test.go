@@ -0,0 +1,79 @@
+package money
+
+import (
+	"math"
+)
+
+func (suite *MoneyTestSuite) TestDecimalString() {
+	suite.Equal("1.50", NewDecimal(150, 2).String())
+	suite.Equal("-1.50", NewDecimal(-150, 2).String())
+	suite.Equal("0.005", NewDecimal(5, 3).String())
+	suite.Equal("-0.005", NewDecimal(-5, 3).String())
+	suite.Equal("0.00", NewDecimal(0, 2).String())
+	suite.Equal("150", NewDecimal(150, 0).String())
+	suite.Equal("0.123456789", NewDecimal(123456789, 9).String())
+	suite.Equal("-922337203.6854775808", NewDecimal(math.MinInt64, 10).String())
+	suite.Equal("0.00000000000000000001", NewDecimal(1, 20).String())
+}
+
+func (suite *MoneyTestSuite) TestDecimalAdd() {
+	// 1.50 + 1.234 aligns to three decimal places
+	result, err := NewDecimal(150, 2).Add(NewDecimal(1234, 3))
+	suite.Nil(err)
+	suite.Equal(NewDecimal(2734, 3), result)
+	suite.Equal("2.734", result.String())
+
+	result, err = NewDecimal(1234, 3).Add(NewDecimal(-150, 2))
+	suite.Nil(err)
+	suite.Equal(NewDecimal(-266, 3), result)
+
+	result, err = NewDecimal(1, 0).Add(NewDecimal(1, 9))
+	suite.Nil(err)
+	suite.Equal("1.000000001", result.String())
+
+	// aligning the scale overflows
+	result, err = NewDecimal(math.MaxInt64/10+1, 0).Add(NewDecimal(1, 1))
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Decimal{}, result)
+
+	result, err = NewDecimal(math.MaxInt64, 2).Add(NewDecimal(1, 2))
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Decimal{}, result)
+}
+
+func (suite *MoneyTestSuite) TestDecimalSub() {
+	result, err := NewDecimal(150, 2).Sub(NewDecimal(1234, 3))
+	suite.Nil(err)
+	suite.Equal(NewDecimal(266, 3), result)
+
+	result, err = NewDecimal(math.MinInt64, 2).Sub(NewDecimal(1, 2))
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Decimal{}, result)
+}
+
+func (suite *MoneyTestSuite) TestDecimalMicroConversion() {
+	d := Micro(801 * Cent).ToDecimal()
+	suite.Equal(int64(8010000), d.Unscaled())
+	suite.Equal(uint8(6), d.Scale())
+	suite.Equal("8.010000", d.String())
+
+	result, err := FromDecimal(d)
+	suite.Nil(err)
+	suite.Equal(801*Cent, result)
+
+	result, err = FromDecimal(NewDecimal(801, 2))
+	suite.Nil(err)
+	suite.Equal(801*Cent, result)
+
+	result, err = FromDecimal(NewDecimal(12345675, 7))
+	suite.Nil(err)
+	suite.Equal(Micro(1234568), result)
+
+	result, err = FromDecimal(NewDecimal(-12345675, 7))
+	suite.Nil(err)
+	suite.Equal(Micro(-1234568), result)
+
+	result, err = FromDecimal(NewDecimal(math.MaxInt64, 0))
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), result)
+}
@@ -0,0 +1,24 @@
+package money
+
+// CompoundInterest returns principal * (1 + ratePerPeriod)^periods, where ratePerPeriod is a fraction, eg.
+// 50000 micros for 5%. The interest of every period is rounded to micros with the given rounding mode before
+// it's added, so the result doesn't depend on floating point math.
+func CompoundInterest(principal Micro, ratePerPeriod Micro, periods int, rounding byte) (Micro, error) {
+	if periods < 0 {
+		return 0, ErrInvalidInput
+	}
+
+	balance := principal
+	for i := 0; i < periods; i++ {
+		interest, err := MulMicro(balance, ratePerPeriod, rounding)
+		if err != nil {
+			return 0, err
+		}
+
+		balance, err = Add(balance, interest)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return balance, nil
+}
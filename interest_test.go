@@ -0,0 +1,41 @@
+package money
+
+func (suite *MoneyTestSuite) TestCompoundInterest() {
+	result, err := CompoundInterest(1000*Dollar, 5*Cent, 3, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(MustFromString("1157.625"), result)
+
+	result, err = CompoundInterest(100*Dollar, 7*Cent, 10, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(MustFromString("196.715134"), result)
+
+	// the interest of every period is rounded, so the mode matters
+	result, err = CompoundInterest(100*Dollar, 33333, 12, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(MustFromString("148.212074"), result)
+
+	result, err = CompoundInterest(100*Dollar, 33333, 12, RoundingFloor)
+	suite.Nil(err)
+	suite.Equal(MustFromString("148.212068"), result)
+
+	// negative rates depreciate
+	result, err = CompoundInterest(1000*Dollar, -10*Cent, 2, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(810*Dollar, result)
+
+	result, err = CompoundInterest(1000*Dollar, 5*Cent, 0, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(1000*Dollar, result)
+
+	result, err = CompoundInterest(1000*Dollar, 5*Cent, -1, RoundingHalfAwayFromZero)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = CompoundInterest(1000*Dollar, Dollar, 100, RoundingHalfAwayFromZero)
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = CompoundInterest(1000*Dollar, 5*Cent, 1, 255)
+	suite.Equal(ErrUnsupportedRounding, err)
+	suite.Equal(Micro(0), result)
+}
@@ -2,11 +2,17 @@ package money
 
 import (
 	"bytes"
+	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"strconv"
 	"strings"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
 )
 
 const (
@@ -14,95 +20,1097 @@ const (
 	precision                      = Micro(1000000)
 	MaxMicro                       = math.MaxInt64
 	MinMicro                       = math.MinInt64
+	MaxAmount                      = Micro(9000000000000000)  // largest amount the package accepts as money
+	MinAmount                      = Micro(-9000000000000000) // smallest amount the package accepts as money
 	Zero                           = Micro(0)
 	MicroDollar              Micro = 1
 	Cent                           = 10000 * MicroDollar
 	Dollar                         = 100 * Cent
 	RoundingNone                   = 0
 	RoundingHalfAwayFromZero       = 1
+	RoundingCeil                   = 2
+	RoundingFloor                  = 3
+	RoundingTowardZero             = 4 // truncates regardless of sign, same as RoundingNone but explicit about intent
+	RoundingHalfUp                 = 5 // ties toward positive infinity, differs from RoundingHalfAwayFromZero only for negative ties
+	RoundingHalfDown               = 6 // ties toward negative infinity, differs from RoundingHalfAwayFromZero only for positive ties
 )
 
-var ErrInvalidInput = errors.New("money: cannot convert string to money.Micro")
-var ErrOverflow = errors.New("money: overflow")
-var ErrZeroDivision = errors.New("money: division by zero")
-var ErrUnsupportedRounding = errors.New("money: unsupported rounding")
+// Errors returned by the package are either one of these sentinels or wrap one of them, so they should be
+// matched with errors.Is.
+var (
+	ErrInvalidInput        = errors.New("money: cannot convert string to money.Micro")
+	ErrOverflow            = errors.New("money: overflow")
+	ErrZeroDivision        = errors.New("money: division by zero")
+	ErrUnsupportedRounding = errors.New("money: unsupported rounding")
+	ErrPrecisionLoss       = errors.New("money: amount has more precision than money.Micro")
+	ErrOverBounds          = errors.New("money: amount is outside of MinAmount and MaxAmount")
+	// ErrEmptyInput is returned for blank input. It wraps ErrInvalidInput, so existing checks still match it.
+	ErrEmptyInput = fmt.Errorf("%w: empty input", ErrInvalidInput)
+)
+
+// RoundingName returns a readable name of the rounding mode for logs and error messages, eg.
+// "half-away-from-zero", or "unknown" for undefined modes.
+func RoundingName(rounding byte) string {
+	switch rounding {
+	case RoundingNone:
+		return "none"
+	case RoundingHalfAwayFromZero:
+		return "half-away-from-zero"
+	case RoundingCeil:
+		return "ceil"
+	case RoundingFloor:
+		return "floor"
+	case RoundingTowardZero:
+		return "toward-zero"
+	case RoundingHalfUp:
+		return "half-up"
+	case RoundingHalfDown:
+		return "half-down"
+	default:
+		return "unknown"
+	}
+}
+
+// IsValidRounding reports whether rounding is one of the defined rounding modes, so that a configured mode
+// can be validated up front instead of returning ErrUnsupportedRounding at calculation time.
+func IsValidRounding(rounding byte) bool {
+	return RoundingName(rounding) != "unknown"
+}
+
+// defaultRounding holds the rounding mode of DivDefault, see SetDefaultRounding.
+var defaultRounding = uint32(RoundingHalfAwayFromZero)
+
+// SetDefaultRounding sets the rounding mode used by DivDefault for applications with a single rounding
+// policy. It returns ErrUnsupportedRounding for undefined modes. It's safe to call concurrently, but it's
+// meant to be called once at startup.
+func SetDefaultRounding(rounding byte) error {
+	if !IsValidRounding(rounding) {
+		return ErrUnsupportedRounding
+	}
+	atomic.StoreUint32(&defaultRounding, uint32(rounding))
+	return nil
+}
+
+// DefaultRounding returns the rounding mode set with SetDefaultRounding, RoundingHalfAwayFromZero by default.
+func DefaultRounding() byte {
+	return byte(atomic.LoadUint32(&defaultRounding))
+}
+
+type Micro int64
+
+func checkBounds(amount Micro) error {
+	if amount > MaxAmount || amount < MinAmount {
+		return ErrOverBounds
+	}
+	return nil
+}
+
+func (micro Micro) MarshalJSON() ([]byte, error) {
+	if err := checkBounds(micro); err != nil {
+		return nil, err
+	}
+
+	result := ToString(micro)
+	return []byte(result), nil
+}
+
+// UnmarshalJSON leaves the value unchanged for a JSON null, as is the convention in encoding/json.
+func (micro *Micro) UnmarshalJSON(src []byte) (err error) {
+	if src == nil || string(src) == "null" {
+		return
+	}
+
+	result, err := FromBytes(bytes.Trim(src, "\""))
+	if err != nil {
+		return err
+	}
+	*micro = result
+	return nil
+}
+
+// StringMicro is a Micro that is encoded as a JSON string ("8.01") instead of a number, so that
+// consumers parsing JSON numbers as floats don't lose precision.
+type StringMicro Micro
+
+func (micro StringMicro) MarshalJSON() ([]byte, error) {
+	if err := checkBounds(Micro(micro)); err != nil {
+		return nil, err
+	}
+
+	result := strconv.Quote(ToString(Micro(micro)))
+	return []byte(result), nil
+}
+
+func (micro *StringMicro) UnmarshalJSON(src []byte) error {
+	return (*Micro)(micro).UnmarshalJSON(src)
+}
+
+// FixedMicro is a Micro that is encoded as a JSON number with exactly two decimal places, eg. 8.00, for
+// consumers that expect it. Sub-cent amounts are rounded half away from zero.
+type FixedMicro Micro
+
+func (micro FixedMicro) MarshalJSON() ([]byte, error) {
+	if err := checkBounds(Micro(micro)); err != nil {
+		return nil, err
+	}
+
+	result, err := ToStringFixed(Micro(micro), 2)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(result), nil
+}
+
+func (micro *FixedMicro) UnmarshalJSON(src []byte) error {
+	return (*Micro)(micro).UnmarshalJSON(src)
+}
+
+func (micro Micro) MarshalText() ([]byte, error) {
+	if err := checkBounds(micro); err != nil {
+		return nil, err
+	}
+
+	result := ToString(micro)
+	return []byte(result), nil
+}
+
+func (micro *Micro) UnmarshalText(text []byte) error {
+	if text == nil {
+		return nil
+	}
+
+	result, err := FromBytes(text)
+	if err != nil {
+		return err
+	}
+	*micro = result
+	return nil
+}
+
+// CSV formats the amount for exports in the same way as Value does for databases, returning ErrOverBounds
+// for amounts outside of MinAmount and MaxAmount.
+func (micro Micro) CSV() (string, error) {
+	if err := checkBounds(micro); err != nil {
+		return "", err
+	}
+
+	return ToString(micro), nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml packages. The amount is written as a
+// quoted string, eg. "8.01", so that decoders which read bare numbers as floats don't lose precision.
+// UnmarshalYAML accepts it either way.
+func (micro Micro) MarshalYAML() (interface{}, error) {
+	if err := checkBounds(micro); err != nil {
+		return nil, err
+	}
+
+	return ToString(micro), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2 (also supported by yaml.v3).
+// Both string and number scalars are parsed with FromString.
+func (micro *Micro) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var text string
+	if err := unmarshal(&text); err != nil {
+		return err
+	}
+
+	result, err := FromString(text)
+	if err != nil {
+		return err
+	}
+	*micro = result
+	return nil
+}
+
+// Proto returns the amount for an int64 micros field of a protobuf message, eg. `int64 amount_micros = 1;`.
+// The field holds raw micros, so 8.01 is sent as 8010000.
+func (micro Micro) Proto() int64 {
+	return int64(micro)
+}
+
+// FromProto reads an int64 micros field written by Proto. Messages come from other services, so it checks
+// the bounds like FromMicros and returns ErrOverBounds outside of MinAmount and MaxAmount.
+func FromProto(micros int64) (Micro, error) {
+	return FromMicros(micros)
+}
+
+// MarshalBinary encodes the amount as a big-endian int64 (8 bytes).
+func (micro Micro) MarshalBinary() ([]byte, error) {
+	result := make([]byte, 8)
+	binary.BigEndian.PutUint64(result, uint64(micro))
+	return result, nil
+}
+
+func (micro *Micro) UnmarshalBinary(data []byte) error {
+	if len(data) != 8 {
+		return ErrInvalidInput
+	}
+
+	*micro = Micro(binary.BigEndian.Uint64(data))
+	return nil
+}
+
+const gobVersion = 1
+
+// GobEncode encodes the amount for encoding/gob as a version byte followed by the MarshalBinary encoding.
+func (micro Micro) GobEncode() ([]byte, error) {
+	data, err := micro.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{gobVersion}, data...), nil
+}
+
+func (micro *Micro) GobDecode(data []byte) error {
+	if len(data) == 0 || data[0] != gobVersion {
+		return ErrInvalidInput
+	}
+
+	return micro.UnmarshalBinary(data[1:])
+}
+
+// FromString parses a decimal amount with an optional sign, eg. "-8.01". Either the integer or the fractional
+// part can be omitted ("5." or ".5"), but not both. Exponent notation ("1.5e3") isn't accepted. Digits beyond
+// the sixth decimal place are rounded half away from zero. Surrounding whitespace, eg. a trailing newline, is
+// ignored, but whitespace inside the number isn't.
+func FromString(amount string) (Micro, error) {
+	return parseFloatString(strings.TrimSpace(amount))
+}
+
+// FromStrings parses every amount with FromString. The first error is returned wrapped with the index of the
+// failing amount.
+func FromStrings(amounts []string) ([]Micro, error) {
+	result := make([]Micro, len(amounts))
+	for i, amount := range amounts {
+		m, err := FromString(amount)
+		if err != nil {
+			return nil, fmt.Errorf("money: amount at index %d: %w", i, err)
+		}
+		result[i] = m
+	}
+	return result, nil
+}
+
+// fromStringsContextInterval is the number of amounts FromStringsContext parses between checks of its context.
+const fromStringsContextInterval = 64
+
+// FromStringsContext is like FromStrings, but checks ctx every few amounts so that callers can bound the
+// work done on large untrusted batches. When ctx is done, ctx.Err() is returned.
+func FromStringsContext(ctx context.Context, amounts []string) ([]Micro, error) {
+	result := make([]Micro, len(amounts))
+	for i, amount := range amounts {
+		if i%fromStringsContextInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		m, err := FromString(amount)
+		if err != nil {
+			return nil, fmt.Errorf("money: amount at index %d: %w", i, err)
+		}
+		result[i] = m
+	}
+	return result, nil
+}
+
+// MustFromString is like FromString but panics if the amount can't be parsed. It simplifies initialization of
+// package level variables and test fixtures.
+func MustFromString(amount string) Micro {
+	result, err := FromString(amount)
+	if err != nil {
+		panic("money: MustFromString(" + strconv.Quote(amount) + "): " + err.Error())
+	}
+	return result
+}
+
+// FromStringClamped is like FromString, but amounts above MaxAmount or below MinAmount, including those that
+// don't fit into Micro at all, are clamped to MaxAmount or MinAmount and reported with true instead of an error.
+// Malformed input still returns ErrInvalidInput.
+func FromStringClamped(amount string) (Micro, bool, error) {
+	amount = strings.TrimSpace(amount)
+	result, err := FromString(amount)
+	if err == ErrOverflow {
+		// the parser stops at the first overflowing digit, so the rest hasn't been validated yet
+		if !isDecimalSyntax(amount) {
+			return 0, false, ErrInvalidInput
+		}
+		if amount[0] == '-' {
+			return MinAmount, true, nil
+		}
+		return MaxAmount, true, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	switch {
+	case result > MaxAmount:
+		return MaxAmount, true, nil
+	case result < MinAmount:
+		return MinAmount, true, nil
+	default:
+		return result, false, nil
+	}
+}
+
+// isDecimalSyntax reports whether amount has the syntax accepted by FromString, regardless of its value.
+func isDecimalSyntax(amount string) bool {
+	if amount != "" && (amount[0] == '+' || amount[0] == '-') {
+		amount = amount[1:]
+	}
+
+	digitsFound := false
+	dotFound := false
+	for i := 0; i < len(amount); i++ {
+		switch c := amount[i]; {
+		case c >= '0' && c <= '9':
+			digitsFound = true
+		case c == '.' && !dotFound:
+			dotFound = true
+		default:
+			return false
+		}
+	}
+	return digitsFound
+}
+
+// FromStringExact is like FromString, but also reports whether the amount had non-zero digits beyond
+// the sixth decimal place, which were rounded away.
+func FromStringExact(amount string) (Micro, bool, error) {
+	return parseDecimal(strings.TrimSpace(amount), precisionExp)
+}
+
+// FromStringStrict is like FromString, but returns ErrPrecisionLoss instead of rounding amounts with
+// non-zero digits beyond the sixth decimal place.
+func FromStringStrict(amount string) (Micro, error) {
+	result, precisionLost, err := parseDecimal(strings.TrimSpace(amount), precisionExp)
+	if err != nil {
+		return 0, err
+	}
+	if precisionLost {
+		return 0, ErrPrecisionLoss
+	}
+	return result, nil
+}
+
+// FromStringGrouped is like FromString, but also accepts underscores as digit group separators, eg.
+// "1_000_000.50". An underscore has to be placed between two digits.
+func FromStringGrouped(amount string) (Micro, error) {
+	if strings.IndexByte(amount, '_') < 0 {
+		return FromString(amount)
+	}
+
+	isDigit := func(c byte) bool {
+		return c >= '0' && c <= '9'
+	}
+
+	buffer := make([]byte, 0, len(amount))
+	for i := 0; i < len(amount); i++ {
+		if amount[i] != '_' {
+			buffer = append(buffer, amount[i])
+			continue
+		}
+
+		if i == 0 || i == len(amount)-1 || !isDigit(amount[i-1]) || !isDigit(amount[i+1]) {
+			return 0, ErrInvalidInput
+		}
+	}
+
+	return FromBytes(buffer)
+}
+
+// FromStringLocale parses amounts written with the given thousands and decimal separators, eg.
+// "1.234,56" with '.' and ','. Thousands separators are optional, but when present they have to
+// separate groups of three digits in the integer part, which can't start with 0.
+func FromStringLocale(amount string, thousandsSep, decimalSep rune) (Micro, error) {
+	if thousandsSep == decimalSep {
+		return 0, ErrInvalidInput
+	}
+
+	buffer := make([]byte, 0, len(amount))
+	// number of integer digits since the start or the last thousands separator
+	groupLength := 0
+	// the first group starts with 0, eg. "0.500", so it's a mistyped decimal rather than thousands
+	leadingZero := false
+	thousandsSepFound := false
+	decimalSepFound := false
+
+	for _, r := range amount {
+		switch {
+		case r == thousandsSep:
+			if decimalSepFound || groupLength == 0 || groupLength > 3 || leadingZero ||
+				(thousandsSepFound && groupLength != 3) {
+				return 0, ErrInvalidInput
+			}
+			thousandsSepFound = true
+			groupLength = 0
+		case r == decimalSep:
+			if thousandsSepFound && groupLength != 3 {
+				return 0, ErrInvalidInput
+			}
+			decimalSepFound = true
+			buffer = append(buffer, '.')
+		case r == '.':
+			// a dot that isn't the decimal separator would be mistaken for one by the parser
+			return 0, ErrInvalidInput
+		default:
+			if r >= '0' && r <= '9' && !decimalSepFound {
+				if !thousandsSepFound && groupLength == 0 && r == '0' {
+					leadingZero = true
+				}
+				groupLength++
+			}
+			buffer = utf8.AppendRune(buffer, r)
+		}
+	}
+
+	if thousandsSepFound && !decimalSepFound && groupLength != 3 {
+		return 0, ErrInvalidInput
+	}
+
+	return FromBytes(buffer)
+}
+
+// FromStringLenient parses user entered amounts like " $1,234.50 " or "1 234,50 kr". It ignores surrounding
+// whitespace, a currency symbol or code before or after the number and thousands separators. Amounts enclosed
+// in parentheses, eg. "(1,234.56)", are negative as in accounting exports. When the number
+// contains both dots and commas, the last one is the decimal separator. A single comma followed by exactly
+// three digits is taken as a thousands separator, unless the number starts with 0, eg. "0,125". Thousands
+// separators have to be the same throughout and separate groups of three digits in the integer part, which
+// can't start with 0. A currency code has at most three letters and is either before or after the number,
+// so "1.2.3", "0.500.000", "5 apples" or "$5$" return ErrInvalidInput, as does "5e", an incomplete exponent.
+func FromStringLenient(amount string) (Micro, error) {
+	isCurrencyRune := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsSymbol(r)
+	}
+	// trimCurrency removes whitespace and a currency symbol or code, eg. "$", "kr" or "US$", from one side of
+	// amount and reports whether there was one. Longer runs of letters are words and a lone "e" is part of
+	// an exponent, which are kept for the parser to reject.
+	trimCurrency := func(amount string, left bool) (string, bool) {
+		trim := strings.TrimRightFunc
+		if left {
+			trim = strings.TrimLeftFunc
+		}
+		countLetters := func(s string) int {
+			letters := 0
+			for _, r := range s {
+				if unicode.IsLetter(r) {
+					letters++
+				}
+			}
+			return letters
+		}
+
+		amount = trim(amount, unicode.IsSpace)
+		rest := trim(amount, isCurrencyRune)
+		token := amount[len(rest):]
+		if left {
+			token = amount[:len(amount)-len(rest)]
+		}
+		if token == "" || token == "e" || token == "E" || countLetters(token) > 3 {
+			return amount, false
+		}
+		return trim(rest, unicode.IsSpace), true
+	}
+
+	amount = strings.TrimSpace(amount)
+	if amount == "" {
+		return 0, ErrEmptyInput
+	}
+
+	parenthesesFound := false
+	if strings.HasPrefix(amount, "(") || strings.HasSuffix(amount, ")") {
+		if len(amount) < 2 || !strings.HasPrefix(amount, "(") || !strings.HasSuffix(amount, ")") {
+			return 0, ErrInvalidInput
+		}
+		amount = strings.TrimSpace(amount[1 : len(amount)-1])
+		parenthesesFound = true
+	}
+
+	// the sign can be on either side of a leading currency token, eg. "-$5" or "$-5"
+	var sign byte
+	if amount != "" && (amount[0] == '-' || amount[0] == '+') {
+		sign = amount[0]
+		amount = amount[1:]
+	}
+	amount, currencyFound := trimCurrency(amount, true)
+	if sign == 0 && amount != "" && (amount[0] == '-' || amount[0] == '+') {
+		sign = amount[0]
+		amount = amount[1:]
+	}
+	// a currency after the number too, eg. "$5$", is left for the parser to reject
+	if !currencyFound {
+		amount, _ = trimCurrency(amount, false)
+	}
+
+	if parenthesesFound {
+		if sign != 0 {
+			return 0, ErrInvalidInput
+		}
+		sign = '-'
+	}
+
+	decimalSep := lenientDecimalSeparator(amount)
+
+	buffer := make([]byte, 0, len(amount)+1)
+	if sign != 0 {
+		buffer = append(buffer, sign)
+	}
+	// same grouping rules as FromStringLocale, with the thousands separator taken from its first use
+	var thousandsSep rune
+	groupLength := 0
+	leadingZero := false
+	decimalSepFound := false
+	for _, r := range amount {
+		switch {
+		case r == decimalSep:
+			if thousandsSep != 0 && groupLength != 3 {
+				return 0, ErrInvalidInput
+			}
+			decimalSepFound = true
+			buffer = append(buffer, '.')
+		case r == '.' || r == ',' || r == '\'' || unicode.IsSpace(r):
+			if decimalSepFound || groupLength == 0 || groupLength > 3 || (thousandsSep == 0 && leadingZero) ||
+				(thousandsSep != 0 && (r != thousandsSep || groupLength != 3)) {
+				return 0, ErrInvalidInput
+			}
+			thousandsSep = r
+			groupLength = 0
+		default:
+			if r >= '0' && r <= '9' && !decimalSepFound {
+				if thousandsSep == 0 && groupLength == 0 && r == '0' {
+					leadingZero = true
+				}
+				groupLength++
+			}
+			buffer = utf8.AppendRune(buffer, r)
+		}
+	}
+	if thousandsSep != 0 && !decimalSepFound && groupLength != 3 {
+		return 0, ErrInvalidInput
+	}
+	// there was something, eg. "$", but no number
+	if len(buffer) == 0 {
+		return 0, ErrInvalidInput
+	}
+
+	return FromBytes(buffer)
+}
+
+// lenientDecimalSeparator guesses the decimal separator of a number, returning 0 if it doesn't have one.
+func lenientDecimalSeparator(amount string) rune {
+	lastDot := strings.LastIndexByte(amount, '.')
+	lastComma := strings.LastIndexByte(amount, ',')
+
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		if lastDot > lastComma {
+			return '.'
+		}
+		return ','
+	case lastDot >= 0:
+		if strings.Count(amount, ".") == 1 {
+			return '.'
+		}
+	case lastComma >= 0:
+		// a thousands group can't follow a leading zero, so "0,125" is a decimal
+		if strings.Count(amount, ",") == 1 && (len(amount)-lastComma-1 != 3 || amount[0] == '0') {
+			return ','
+		}
+	}
+	return 0
+}
+
+// ParseError is returned by ParseDetailed for invalid input. It unwraps to ErrInvalidInput.
+type ParseError struct {
+	Input  string
+	Offset int // byte offset of the first invalid character, or the length of Input if it ended too early
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("money: cannot convert %s to money.Micro: invalid character at offset %d", strconv.Quote(e.Input), e.Offset)
+}
+
+func (e *ParseError) Unwrap() error {
+	return ErrInvalidInput
+}
+
+// ParseDetailed is like FromString, but invalid input returns a *ParseError with the position of the problem,
+// which helps with validation messages for user input. Other errors, eg. ErrEmptyInput or ErrOverflow, are
+// returned as they are.
+func ParseDetailed(amount string) (Micro, error) {
+	// offsets are reported in the untrimmed input
+	trimmed := strings.TrimLeftFunc(amount, unicode.IsSpace)
+	leadingSpace := len(amount) - len(trimmed)
+	trimmed = strings.TrimRightFunc(trimmed, unicode.IsSpace)
+
+	result, _, offset, err := parseDecimalOffset(trimmed, precisionExp)
+	if err == ErrInvalidInput {
+		return 0, &ParseError{Input: amount, Offset: leadingSpace + offset}
+	}
+	return result, err
+}
+
+// FromBytes is like FromString, but parses a byte slice directly without converting it to a string first.
+func FromBytes(amount []byte) (Micro, error) {
+	return parseFloatString(bytes.TrimSpace(amount))
+}
+
+// ToString formats the amount without trailing zeros, eg. "8.01". Every Micro value has an exact decimal
+// representation, so formatting can't fail and there is no bounds check.
+func ToString(amount Micro) string {
+	return string(amount.AppendString(make([]byte, 0, 24)))
+}
+
+// ToStringSigned is like ToString, but prefixes positive amounts with a plus sign, eg. "+5" for deltas in
+// ledgers and change logs. Zero has no sign.
+func ToStringSigned(amount Micro) string {
+	if amount > 0 {
+		return string(amount.AppendString([]byte{'+'}))
+	}
+	return ToString(amount)
+}
+
+// AppendString appends the ToString representation of the amount to dst and returns the extended buffer.
+func (micro Micro) AppendString(dst []byte) []byte {
+	return appendDecimal(dst, int64(micro), precisionExp, int64(precision))
+}
+
+// appendDecimal appends amount / unit, where unit is 10^decimalPlaces, without trailing zeros.
+func appendDecimal(dst []byte, amount int64, decimalPlaces int64, unit int64) []byte {
+	decimal := amount / unit
+	fraction := amount % unit
+
+	if fraction < 0 {
+		fraction = -fraction
+
+		// we can lose negative sign with division, eg. -999999/1000000 = 0
+		if decimal == 0 {
+			dst = append(dst, '-')
+		}
+	}
+
+	dst = strconv.AppendInt(dst, decimal, 10)
+	if fraction > 0 {
+		dst = append(dst, '.')
+
+		// zero padded fraction without trailing zeros
+		digits := decimalPlaces
+		for fraction%10 == 0 {
+			fraction /= 10
+			digits--
+		}
+
+		var buffer [18]byte
+		for i := digits - 1; i >= 0; i-- {
+			buffer[i] = byte('0' + fraction%10)
+			fraction /= 10
+		}
+		dst = append(dst, buffer[:digits]...)
+	}
+
+	return dst
+}
+
+// ToScientificString formats the amount in scientific notation without trailing zeros, eg. "1.23456e3" for
+// 1234.56 or "5e-1" for 0.5. Zero is "0e0". It returns ErrOverBounds for amounts outside of MinAmount and
+// MaxAmount.
+func ToScientificString(amount Micro) (string, error) {
+	if err := checkBounds(amount); err != nil {
+		return "", err
+	}
+	if amount == 0 {
+		return "0e0", nil
+	}
+
+	buffer := make([]byte, 0, 24)
+	if amount < 0 {
+		buffer = append(buffer, '-')
+		amount = -amount
+	}
+
+	allDigits := strconv.FormatInt(int64(amount), 10)
+	exponent := int64(len(allDigits)) - 1 - precisionExp
+	digits := strings.TrimRight(allDigits, "0")
+
+	buffer = append(buffer, digits[0])
+	if len(digits) > 1 {
+		buffer = append(buffer, '.')
+		buffer = append(buffer, digits[1:]...)
+	}
+	buffer = append(buffer, 'e')
+	buffer = strconv.AppendInt(buffer, exponent, 10)
+
+	return string(buffer), nil
+}
+
+// GoString implements fmt.GoStringer so that %#v shows both the raw value and the decimal amount.
+func (micro Micro) GoString() string {
+	return "money.Micro(" + strconv.FormatInt(int64(micro), 10) + " /* " + ToString(micro) + " */)"
+}
+
+// ToStringFixed formats amount with exactly the given number of decimal places, rounding half away
+// from zero when decimals is less than 6 and padding with zeros when it's more.
+func ToStringFixed(amount Micro, decimals int) (string, error) {
+	if decimals < 0 {
+		return "", ErrInvalidInput
+	}
+
+	if decimals < int(precisionExp) {
+		unit := precision
+		for i := 0; i < decimals; i++ {
+			unit /= 10
+		}
+
+		rounded, err := Round(amount, unit, RoundingHalfAwayFromZero)
+		if err != nil {
+			return "", err
+		}
+		amount = rounded
+	}
+
+	decimal := amount / precision
+	fraction := amount % precision
+
+	buffer := bytes.Buffer{}
+
+	if fraction < 0 {
+		fraction = -fraction
+
+		// we can lose negative sign with division, eg. -999999/1000000 = 0
+		if decimal == 0 {
+			buffer.WriteString("-")
+		}
+	}
+
+	buffer.WriteString(strconv.FormatInt(int64(decimal), 10))
+	if decimals > 0 {
+		buffer.WriteRune('.')
+
+		fractionString := fmt.Sprintf("%06d", int64(fraction))
+		if decimals < int(precisionExp) {
+			// rounding above guarantees the dropped digits are zeros
+			buffer.WriteString(fractionString[:decimals])
+		} else {
+			buffer.WriteString(fractionString)
+			buffer.WriteString(strings.Repeat("0", decimals-int(precisionExp)))
+		}
+	}
+
+	return buffer.String(), nil
+}
+
+// ToFloatStringFixed is ToStringFixed for exported feeds, which need a fixed number of decimal places. Like
+// CSV, it returns ErrOverBounds for amounts outside of MinAmount and MaxAmount.
+func ToFloatStringFixed(amount Micro, decimals int) (string, error) {
+	if err := checkBounds(amount); err != nil {
+		return "", err
+	}
+	return ToStringFixed(amount, decimals)
+}
+
+// Format formats amount like ToStringFixed, but separates every three digits of the integer part with
+// thousandsSep and uses decimalSep in front of the fraction, eg. "1,234,567.89".
+func Format(amount Micro, decimals int, thousandsSep, decimalSep string) (string, error) {
+	fixed, err := ToStringFixed(amount, decimals)
+	if err != nil {
+		return "", err
+	}
+
+	buffer := bytes.Buffer{}
+
+	if fixed[0] == '-' {
+		buffer.WriteRune('-')
+		fixed = fixed[1:]
+	}
+
+	integerPart := fixed
+	fractionPart := ""
+	if dot := strings.IndexByte(fixed, '.'); dot >= 0 {
+		integerPart = fixed[:dot]
+		fractionPart = fixed[dot+1:]
+	}
 
-type Micro int64
+	for i := 0; i < len(integerPart); i++ {
+		if i > 0 && (len(integerPart)-i)%3 == 0 {
+			buffer.WriteString(thousandsSep)
+		}
+		buffer.WriteByte(integerPart[i])
+	}
 
-func (micro Micro) MarshalJSON() ([]byte, error) {
-	result := ToString(micro)
-	return []byte(result), nil
+	if decimals > 0 {
+		buffer.WriteString(decimalSep)
+		buffer.WriteString(fractionPart)
+	}
+
+	return buffer.String(), nil
 }
 
-func (micro *Micro) UnmarshalJSON(src []byte) (err error) {
-	if src == nil {
-		return
+// FormatCurrency formats amount like ToStringFixed with a currency symbol either in front of the
+// number ("$1234.56") or after it, separated by a space ("1234.56 €"). The sign always comes first.
+func FormatCurrency(amount Micro, symbol string, symbolBefore bool, decimals int) (string, error) {
+	fixed, err := ToStringFixed(amount, decimals)
+	if err != nil {
+		return "", err
+	}
+
+	if !symbolBefore {
+		return fixed + " " + symbol, nil
+	}
+
+	if fixed[0] == '-' {
+		return "-" + symbol + fixed[1:], nil
 	}
+	return symbol + fixed, nil
+}
 
-	result, err := FromString(strings.Trim(string(src), "\""))
+// FormatAccounting formats amount like ToStringFixed, but wraps negative amounts in parentheses
+// instead of prefixing them with a minus sign, eg. "(5.00)".
+func FormatAccounting(amount Micro, decimals int) (string, error) {
+	fixed, err := ToStringFixed(amount, decimals)
 	if err != nil {
-		return err
+		return "", err
 	}
-	*micro = result
-	return nil
+
+	if fixed[0] == '-' {
+		return "(" + fixed[1:] + ")", nil
+	}
+	return fixed, nil
 }
 
-func FromString(amount string) (Micro, error) {
-	return parseFloatString(amount)
+// FromFloat64 converts amount to Micro, rounding anything below a micro half away from zero. See
+// FromFloat64Rounding.
+func FromFloat64(amount float64) (Micro, error) {
+	return FromFloat64Rounding(amount, RoundingHalfAwayFromZero)
 }
 
-func ToString(amount Micro) string {
-	decimal := amount / precision
-	fraction := amount % precision
+// FromFloat64Rounding converts amount to Micro, rounding anything below a micro with the given rounding mode.
+// It rounds the shortest decimal representation of amount, as printed by strconv, so the result is the same as
+// formatting the float and parsing it, eg. 0.0000025 is a tie, but it doesn't allocate. NaN returns
+// ErrInvalidInput, infinities ErrOverflow and rounded amounts outside of MinAmount and MaxAmount ErrOverBounds.
+func FromFloat64Rounding(amount float64, rounding byte) (Micro, error) {
+	// NaN fails every comparison below, so it has to be rejected explicitly
+	if math.IsNaN(amount) {
+		return 0, ErrInvalidInput
+	}
+	if math.IsInf(amount, 0) {
+		return 0, ErrOverflow
+	}
+	if !IsValidRounding(rounding) {
+		return 0, ErrUnsupportedRounding
+	}
+	// far outside of the bounds whatever the rounding, which also keeps roundFloat64Decimal's buffer small
+	if math.Abs(amount) > 1e10 {
+		return 0, ErrOverBounds
+	}
 
-	buffer := bytes.Buffer{}
+	var resultFloat float64
+	scaled := amount * float64(precision)
+	if nearRoundingBoundary(scaled) {
+		resultFloat = roundFloat64Decimal(amount, rounding)
+	} else {
+		resultFloat, _ = roundFloat64(scaled, rounding)
+	}
 
-	if fraction < 0 {
-		fraction = -fraction
+	// MinAmount and MaxAmount are exact in float64, unlike MinMicro and MaxMicro, so the check is reliable
+	if resultFloat > float64(MaxAmount) || resultFloat < float64(MinAmount) {
+		return 0, ErrOverBounds
+	}
+	result := int64(resultFloat)
 
-		// we can lose negative sign with division, eg. -999999/1000000 = 0
-		if decimal == 0 {
-			buffer.WriteString("-")
+	return Micro(result), nil
+}
+
+// nearRoundingBoundary reports whether scaled micros are so close to a whole micro or to a half micro that the
+// error of scaling the float could change how it's rounded. Both the scaling and the shortest decimal
+// representation are within 1.5 ulp of the exact value, so anything further than 4 ulp is safe.
+func nearRoundingBoundary(scaled float64) bool {
+	scaled = math.Abs(scaled)
+	tolerance := 4 * (math.Nextafter(scaled, math.Inf(1)) - scaled)
+	fraction := scaled - math.Floor(scaled)
+
+	return fraction < tolerance || 1-fraction < tolerance || math.Abs(fraction-0.5) < tolerance
+}
+
+// roundFloat64Decimal rounds the shortest decimal representation of amount to micros. amount has to be finite
+// and at most 1e10 in absolute value.
+func roundFloat64Decimal(amount float64, rounding byte) float64 {
+	negative := amount < 0
+	amount = math.Abs(amount)
+
+	// digits below the sixth decimal place compared with half a micro
+	const (
+		exact = iota
+		belowHalf
+		half
+		aboveHalf
+	)
+
+	var micros int64
+	var remainder int
+	if amount < 1e-7 {
+		// 'f' formatting of tiny amounts would be long, but they are all below half a micro
+		remainder = belowHalf
+		if amount == 0 {
+			remainder = exact
+		}
+	} else {
+		var buffer [64]byte
+		digits := strconv.AppendFloat(buffer[:0], amount, 'f', -1, 64)
+
+		fractionDigits := 0
+		for i, c := range digits {
+			if c == '.' {
+				fractionDigits = len(digits) - i - 1
+				break
+			}
+		}
+
+		tail := []byte(nil)
+		if fractionDigits > int(precisionExp) {
+			tail = digits[len(digits)-fractionDigits+int(precisionExp):]
+			digits = digits[:len(digits)-len(tail)]
+		}
+		// at most 6 decimal places, so nothing is rounded and the amount always fits
+		result, _, _ := parseDecimal(digits, precisionExp)
+		micros = int64(result)
+
+		switch {
+		case len(tail) == 0:
+			remainder = exact
+		case tail[0] < '5':
+			remainder = belowHalf
+		case tail[0] > '5' || len(tail) > 1:
+			// shortest representations have no trailing zeros, so any further digit is non-zero
+			remainder = aboveHalf
+		default:
+			remainder = half
 		}
 	}
 
-	buffer.WriteString(strconv.FormatInt(int64(decimal), 10))
-	if fraction > 0 {
-		buffer.WriteRune('.')
-		buffer.WriteString(fmt.Sprintf("%06d", int64(fraction)))
+	roundUp := false
+	switch rounding {
+	case RoundingHalfAwayFromZero:
+		roundUp = remainder >= half
+	case RoundingHalfUp:
+		roundUp = remainder == aboveHalf || (remainder == half && !negative)
+	case RoundingHalfDown:
+		roundUp = remainder == aboveHalf || (remainder == half && negative)
+	case RoundingCeil:
+		roundUp = remainder != exact && !negative
+	case RoundingFloor:
+		roundUp = remainder != exact && negative
+	}
+	if roundUp {
+		micros++
 	}
 
-	result := ""
-	if fraction > 0 {
-		result = strings.TrimRight(buffer.String(), "0")
-	} else {
-		result = buffer.String()
+	if negative {
+		return -float64(micros)
 	}
+	return float64(micros)
+}
 
-	return result
+func roundFloat64(value float64, rounding byte) (float64, error) {
+	switch rounding {
+	case RoundingNone, RoundingTowardZero:
+		return math.Trunc(value), nil
+	case RoundingHalfAwayFromZero:
+		return math.Round(value), nil
+	case RoundingHalfUp:
+		// value - floor is exact, so ties are detected reliably
+		floor := math.Floor(value)
+		if value-floor >= 0.5 {
+			return floor + 1, nil
+		}
+		return floor, nil
+	case RoundingHalfDown:
+		ceil := math.Ceil(value)
+		if ceil-value >= 0.5 {
+			return ceil - 1, nil
+		}
+		return ceil, nil
+	case RoundingCeil:
+		return math.Ceil(value), nil
+	case RoundingFloor:
+		return math.Floor(value), nil
+	default:
+		return 0, ErrUnsupportedRounding
+	}
 }
 
-func FromFloat64(amount float64) (Micro, error) {
-	fPrecision := float64(precision)
-	if amount > float64(MaxMicro)/fPrecision || amount < float64(MinMicro)/fPrecision {
-		return 0, ErrOverflow
+// FromMicros converts raw micros, eg. from a BIGINT column, to Micro, returning ErrOverBounds for values
+// outside of MinAmount and MaxAmount, which ValueInt64 and the marshalers don't write either.
+func FromMicros(micros int64) (Micro, error) {
+	if err := checkBounds(Micro(micros)); err != nil {
+		return 0, err
 	}
+	return Micro(micros), nil
+}
 
-	resultFloat := amount * fPrecision
-	result := int64(resultFloat)
+func FromCents(cents int64) (Micro, error) {
+	return Mul(Cent, cents)
+}
 
-	return Micro(result), nil
+// ToCents returns the amount in whole cents, rounding any sub-cent remainder with the given rounding mode.
+func ToCents(amount Micro, rounding byte) (int64, error) {
+	result, err := Div(amount, int64(Cent), rounding)
+	return int64(result), err
+}
+
+// ToCentsString returns the amount in whole cents as a base-10 string, eg. "1099" for 10.99, which is what
+// payment gateways expect. Sub-cent remainders are rounded with the given rounding mode.
+func ToCentsString(amount Micro, rounding byte) (string, error) {
+	cents, err := ToCents(amount, rounding)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(cents, 10), nil
+}
+
+func FromInt64Dollar(dollars int64) (Micro, error) {
+	return Mul(Dollar, dollars)
 }
 
+// ToInt64Dollar returns the amount in whole dollars, rounding any remainder with the given rounding mode.
+func ToInt64Dollar(amount Micro, rounding byte) (int64, error) {
+	result, err := Div(amount, int64(Dollar), rounding)
+	return int64(result), err
+}
+
+// ToFloat64 converts the amount to dollars as a float64. Like the other conversions out of Micro, it returns
+// ErrOverBounds for amounts outside of MinAmount and MaxAmount, which also keeps the conversion exact to a micro.
 func ToFloat64(amount Micro) (float64, error) {
+	if err := checkBounds(amount); err != nil {
+		return 0, err
+	}
+
 	result := float64(amount) / float64(precision)
 
 	return result, nil
 }
 
-func parseFloatString(amount string) (Micro, error) {
+func parseFloatString[T string | []byte](amount T) (Micro, error) {
+	result, _, err := parseDecimal(amount, precisionExp)
+	return result, err
+}
+
+// parseDecimal works on both strings and byte slices so that FromBytes doesn't need to convert its input.
+// The result has the given number of decimal places and it also reports whether any non-zero digits beyond
+// them were rounded away.
+func parseDecimal[T string | []byte](amount T, decimalPlaces int64) (Micro, bool, error) {
+	result, precisionLost, _, err := parseDecimalOffset(amount, decimalPlaces)
+	return result, precisionLost, err
+}
+
+// parseDecimalOffset is parseDecimal that also returns the byte offset of the first invalid character with
+// ErrInvalidInput. When the input ends too early, eg. "-", the offset is its length.
+func parseDecimalOffset[T string | []byte](amount T, decimalPlaces int64) (Micro, bool, int, error) {
 	if len(amount) == 0 {
-		return Micro(0), ErrInvalidInput
+		return Micro(0), false, 0, ErrEmptyInput
 	}
 
 	result := uint64(0)
@@ -112,6 +1120,7 @@ func parseFloatString(amount string) (Micro, error) {
 	significantDigitFound := false
 	dotFound := false
 	decimalPartLength := int64(0)
+	precisionLost := false
 
 	i := 0
 	switch amount[i] {
@@ -126,7 +1135,7 @@ func parseFloatString(amount string) (Micro, error) {
 		switch c := amount[i]; true {
 		case c == '.':
 			if dotFound {
-				return 0, ErrInvalidInput
+				return 0, false, i, ErrInvalidInput
 			}
 
 			dotFound = true
@@ -138,26 +1147,31 @@ func parseFloatString(amount string) (Micro, error) {
 			}
 			significantDigitFound = true
 
-			// precisonExp + 1 so that we can do rounding in the end if necessary
-			if decimalPartLength == precisionExp+1 {
+			// decimalPlaces + 1 so that we can do rounding in the end if necessary
+			if decimalPartLength == decimalPlaces+1 {
+				if c != '0' {
+					precisionLost = true
+				}
 				continue
 			}
 
+			// Without exponents nothing can make the amount smaller again, so every overflow below returns
+			// right away instead of scanning the rest of a possibly huge input.
 			newResult := result * 10
 			// overflow
 			if result != newResult/10 {
-				return 0, ErrOverflow
+				return 0, false, 0, ErrOverflow
 			}
 
 			newResult += uint64(c - '0')
 			// This overflow check is valid because digits can only be 0-9.
 			if newResult < result*10 {
-				return 0, ErrOverflow
+				return 0, false, 0, ErrOverflow
 			}
 
 			// In the end, we use signed int64 and this makes sure it doesn't overflow
 			if (sign == 1 && newResult > 1<<63-1) || (sign == -1 && newResult > 1<<63) {
-				return 0, ErrOverflow
+				return 0, false, 0, ErrOverflow
 			}
 
 			if dotFound {
@@ -166,15 +1180,20 @@ func parseFloatString(amount string) (Micro, error) {
 
 			result = newResult
 		default:
-			return 0, ErrInvalidInput
+			return 0, false, i, ErrInvalidInput
 		}
 	}
+	// A dot needs digits on at least one side, so "5." and ".5" are valid, but ".", "-." or "+" are not.
 	if !digitsFound {
-		return 0, ErrInvalidInput
+		return 0, false, len(amount), ErrInvalidInput
 	}
 
-	// If this is true, it can only be precisionExp + 1 decimal places (see how we handle this in switch above)
-	if decimalPartLength > precisionExp {
+	// If this is true, it can only be decimalPlaces + 1 decimal places (see how we handle this in switch above)
+	if decimalPartLength > decimalPlaces {
+		if result%10 != 0 {
+			precisionLost = true
+		}
+
 		// rounding
 		if result%10 >= 5 {
 			newResult := result + 10
@@ -185,19 +1204,25 @@ func parseFloatString(amount string) (Micro, error) {
 		}
 		result /= 10
 	} else {
-		for i := int64(0); i < precisionExp-decimalPartLength; i++ {
+		for i := int64(0); i < decimalPlaces-decimalPartLength; i++ {
 			newResult := result * 10
 			// Overflow
 			if result != newResult/10 {
-				return 0, ErrOverflow
+				return 0, false, 0, ErrOverflow
 			}
 			result = newResult
 		}
 	}
 
+	// Padding with zeros above only checks for uint64 overflow, eg. "9300000000000" fits into uint64 micros,
+	// but not into int64 ones.
+	if (sign == 1 && result > 1<<63-1) || (sign == -1 && result > 1<<63) {
+		return 0, false, 0, ErrOverflow
+	}
+
 	resultSigned := int64(result) * sign
 
-	return Micro(resultSigned), nil
+	return Micro(resultSigned), precisionLost, 0, nil
 }
 
 func Add(a Micro, b Micro) (Micro, error) {
@@ -212,6 +1237,18 @@ func Add(a Micro, b Micro) (Micro, error) {
 	return result, nil
 }
 
+func Sub(a Micro, b Micro) (Micro, error) {
+	result := a - b
+
+	if a < 0 && b > 0 && result >= 0 {
+		return 0, ErrOverflow
+	}
+	if a >= 0 && b < 0 && result < 0 {
+		return 0, ErrOverflow
+	}
+	return result, nil
+}
+
 func Mul(amount Micro, multiplier int64) (Micro, error) {
 	var mult = Micro(multiplier)
 	result := amount * mult
@@ -223,29 +1260,398 @@ func Mul(amount Micro, multiplier int64) (Micro, error) {
 	return result, nil
 }
 
-func divideAndRoundHalfAwayFromZero(a Micro, b Micro) Micro {
-	if (a < 0 || b < 0) && !(a < 0 && b < 0) {
-		return (a - (b / 2)) / b
+// divideAndRoundHalf rounds to the nearest integer and breaks ties with one of the half rounding modes.
+func divideAndRoundHalf(a Micro, b Micro, rounding byte) Micro {
+	result := a / b
+	remainder := a % b
+	if remainder == 0 {
+		return result
+	}
+
+	// Compare remainder with half of the divisor on absolute values so that adding b/2 to a can't overflow.
+	absRemainder := uint64(remainder)
+	if remainder < 0 {
+		absRemainder = uint64(-remainder)
+	}
+	absDivisor := uint64(b)
+	if b < 0 {
+		absDivisor = uint64(-b)
+	}
+
+	positive := (remainder < 0) == (b < 0)
+	away := absRemainder > absDivisor-absRemainder
+	if absRemainder == absDivisor-absRemainder {
+		switch rounding {
+		case RoundingHalfUp:
+			away = positive
+		case RoundingHalfDown:
+			away = !positive
+		default:
+			away = true
+		}
+	}
+
+	if away {
+		if positive {
+			result++
+		} else {
+			result--
+		}
+	}
+	return result
+}
+
+func divideAndRoundCeil(a Micro, b Micro) Micro {
+	result := a / b
+	// remainder has the same sign as the exact quotient, truncation went toward negative infinity
+	if remainder := a % b; remainder != 0 && (remainder < 0) == (b < 0) {
+		result++
+	}
+	return result
+}
+
+func divideAndRoundFloor(a Micro, b Micro) Micro {
+	result := a / b
+	// exact quotient is negative, truncation went toward positive infinity
+	if remainder := a % b; remainder != 0 && (remainder < 0) != (b < 0) {
+		result--
+	}
+	return result
+}
+
+func divideAndRound(a Micro, b Micro, rounding byte) (Micro, error) {
+	switch rounding {
+	case RoundingNone, RoundingTowardZero:
+		return a / b, nil
+	case RoundingHalfAwayFromZero, RoundingHalfUp, RoundingHalfDown:
+		return divideAndRoundHalf(a, b, rounding), nil
+	case RoundingCeil:
+		return divideAndRoundCeil(a, b), nil
+	case RoundingFloor:
+		return divideAndRoundFloor(a, b), nil
+	default:
+		return 0, ErrUnsupportedRounding
+	}
+}
+
+// divideBigAndRound is divideAndRound for arbitrarily large operands. It returns ErrOverflow when the
+// rounded result doesn't fit into Micro.
+func divideBigAndRound(a *big.Int, b *big.Int, rounding byte) (Micro, error) {
+	result, remainder := new(big.Int).QuoRem(a, b, new(big.Int))
+
+	// direction away from zero of the exact quotient, 0 if there is nothing to round
+	direction := int64(remainder.Sign() * b.Sign())
+
+	switch rounding {
+	case RoundingNone, RoundingTowardZero:
+		direction = 0
+	case RoundingHalfAwayFromZero, RoundingHalfUp, RoundingHalfDown:
+		doubledRemainder := new(big.Int).Lsh(new(big.Int).Abs(remainder), 1)
+		switch cmp := doubledRemainder.Cmp(new(big.Int).Abs(b)); {
+		case cmp < 0:
+			direction = 0
+		case cmp == 0 && rounding == RoundingHalfUp && direction < 0:
+			direction = 0
+		case cmp == 0 && rounding == RoundingHalfDown && direction > 0:
+			direction = 0
+		}
+	case RoundingCeil:
+		if direction < 0 {
+			direction = 0
+		}
+	case RoundingFloor:
+		if direction > 0 {
+			direction = 0
+		}
+	default:
+		return 0, ErrUnsupportedRounding
+	}
+
+	result.Add(result, big.NewInt(direction))
+	if !result.IsInt64() {
+		return 0, ErrOverflow
 	}
-	return (a + (b / 2)) / b
+	return Micro(result.Int64()), nil
 }
 
+// Div divides amount by divisor and rounds the quotient with the given rounding mode. Rounding compares the
+// remainder with the divisor instead of adding half of the divisor to amount, so it doesn't overflow near
+// MinMicro and MaxMicro, eg. Div(MaxMicro, 2, RoundingHalfAwayFromZero) is MaxMicro/2 + 1. The only quotient
+// that doesn't fit, MinMicro / -1, returns ErrOverflow.
 func Div(amount Micro, divisor int64, rounding byte) (Micro, error) {
 	var div = Micro(divisor)
-	var result = Micro(0)
 
 	if div == 0 {
-		return result, ErrZeroDivision
+		return 0, ErrZeroDivision
+	}
+	// the only quotient that doesn't fit, -MinMicro, would silently wrap around
+	if amount == MinMicro && divisor == -1 {
+		return 0, ErrOverflow
 	}
 
-	switch rounding {
-	case RoundingNone:
-		result = amount / div
-	case RoundingHalfAwayFromZero:
-		result = divideAndRoundHalfAwayFromZero(amount, div)
+	return divideAndRound(amount, div, rounding)
+}
+
+// DivDefault is Div with the rounding mode set by SetDefaultRounding.
+func DivDefault(amount Micro, divisor int64) (Micro, error) {
+	return Div(amount, divisor, DefaultRounding())
+}
+
+// DivMod returns amount / divisor truncated toward zero together with the remainder, which has the sign of
+// amount, so that quotient*divisor + remainder == amount.
+func DivMod(amount Micro, divisor int64) (quotient Micro, remainder Micro, err error) {
+	if divisor == 0 {
+		return 0, 0, ErrZeroDivision
+	}
+	// the only quotient that doesn't fit, -MinMicro
+	if amount == MinMicro && divisor == -1 {
+		return 0, 0, ErrOverflow
+	}
+
+	div := Micro(divisor)
+	return amount / div, amount % div, nil
+}
+
+// Negate flips the sign of the amount in place. MinMicro has no positive counterpart, so it returns ErrOverflow
+// and leaves the amount unchanged.
+func (micro *Micro) Negate() error {
+	if *micro == MinMicro {
+		return ErrOverflow
+	}
+	*micro = -*micro
+	return nil
+}
+
+// MakeAbs replaces the amount with its absolute value in place. Like Negate, it returns ErrOverflow for
+// MinMicro and leaves it unchanged.
+func (micro *Micro) MakeAbs() error {
+	if *micro < 0 {
+		return micro.Negate()
+	}
+	return nil
+}
+
+// DollarsAndMicros splits the amount into whole dollars and the remaining micros. Both have the sign of the
+// amount, so the sign of eg. -0.5 is kept in micros.
+func (micro Micro) DollarsAndMicros() (dollars int64, micros int64) {
+	return int64(micro / precision), int64(micro % precision)
+}
+
+// EqualString reports whether m equals the amount in s, regardless of how s is written, eg. "8.010" equals
+// 801*Cent. The error of parsing s with FromString is returned if it's invalid.
+func EqualString(m Micro, s string) (bool, error) {
+	parsed, err := FromString(s)
+	if err != nil {
+		return false, err
+	}
+	return m == parsed, nil
+}
+
+// Equal reports whether micro == other.
+func (micro Micro) Equal(other Micro) bool {
+	return micro == other
+}
+
+// LessThan reports whether micro < other, eg. balance.LessThan(cost).
+func (micro Micro) LessThan(other Micro) bool {
+	return micro < other
+}
+
+// GreaterThan reports whether micro > other.
+func (micro Micro) GreaterThan(other Micro) bool {
+	return micro > other
+}
+
+// Between reports whether low <= micro <= high.
+func (micro Micro) Between(low Micro, high Micro) bool {
+	return low <= micro && micro <= high
+}
+
+// InRange is like Between, but returns ErrInvalidInput when low is greater than high.
+func (micro Micro) InRange(low Micro, high Micro) (bool, error) {
+	if low > high {
+		return false, ErrInvalidInput
+	}
+	return micro.Between(low, high), nil
+}
+
+// HasSubCent reports whether the amount has micros below the cent level, which a cents-only system would lose.
+func (micro Micro) HasSubCent() bool {
+	return micro%Cent != 0
+}
+
+// Range is an inclusive window of amounts, eg. the minimum and maximum of a campaign budget.
+type Range struct {
+	Min Micro
+	Max Micro
+}
+
+// Valid reports whether the range isn't empty, ie. Min <= Max.
+func (r Range) Valid() bool {
+	return r.Min <= r.Max
+}
+
+// Contains reports whether Min <= m <= Max. An invalid range contains nothing.
+func (r Range) Contains(m Micro) bool {
+	return m.Between(r.Min, r.Max)
+}
+
+// Clamp returns m limited to the range. For an invalid range the result is undefined, so check Valid first.
+func (r Range) Clamp(m Micro) Micro {
+	switch {
+	case m < r.Min:
+		return r.Min
+	case m > r.Max:
+		return r.Max
 	default:
-		return result, ErrUnsupportedRounding
+		return m
 	}
+}
 
-	return result, nil
+// TruncateToCent drops any sub-cent micros, truncating toward zero.
+func (micro Micro) TruncateToCent() Micro {
+	return micro - micro%Cent
+}
+
+// TruncateTo truncates the amount toward zero to a multiple of unit. Unlike Round, it never rounds up.
+func (micro Micro) TruncateTo(unit Micro) (Micro, error) {
+	if unit <= 0 {
+		return 0, ErrInvalidInput
+	}
+
+	return micro - micro%unit, nil
+}
+
+// Scale multiplies amount by 10^powerOfTen. Negative powers divide and round half away from zero,
+// eg. Scale(amount, -3) converts a CPM to the price of a single impression.
+func Scale(amount Micro, powerOfTen int) (Micro, error) {
+	switch {
+	case powerOfTen >= 0:
+		result := amount
+		// any non-zero amount overflows after at most 19 iterations
+		for i := 0; i < powerOfTen && result != 0; i++ {
+			var err error
+			result, err = Mul(result, 10)
+			if err != nil {
+				return 0, err
+			}
+		}
+		return result, nil
+	case powerOfTen < -19:
+		// every int64 is less than half of 10^20, so it rounds to zero
+		return 0, nil
+	default:
+		divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-powerOfTen)), nil)
+		return divideBigAndRound(big.NewInt(int64(amount)), divisor, RoundingHalfAwayFromZero)
+	}
+}
+
+// Ratio returns a / b, eg. the share of a budget that was spent.
+func Ratio(a Micro, b Micro) (float64, error) {
+	if b == 0 {
+		return 0, ErrZeroDivision
+	}
+
+	return float64(a) / float64(b), nil
+}
+
+// PercentChange returns the change from oldAmount to newAmount in percent of oldAmount, eg. 50 when the amount
+// grew by a half. The difference is computed with Sub, so it returns ErrOverflow instead of wrapping around.
+func PercentChange(oldAmount Micro, newAmount Micro) (float64, error) {
+	if oldAmount == 0 {
+		return 0, ErrZeroDivision
+	}
+
+	difference, err := Sub(newAmount, oldAmount)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(difference) / float64(oldAmount) * 100, nil
+}
+
+// Round rounds amount to a multiple of unit (eg. Cent or Dollar) using the given rounding mode.
+func Round(amount Micro, unit Micro, rounding byte) (Micro, error) {
+	if unit <= 0 {
+		return 0, ErrInvalidInput
+	}
+
+	units, err := divideAndRound(amount, unit, rounding)
+	if err != nil {
+		return 0, err
+	}
+
+	return Mul(units, int64(unit))
+}
+
+// Quantize snaps amount to a grid of step micros, eg. bids in steps of 5 cents, using the given rounding
+// mode. Unlike Round, step doesn't have to be a Cent or a Dollar, but the result is the same.
+func Quantize(amount Micro, step Micro, rounding byte) (Micro, error) {
+	return Round(amount, step, rounding)
+}
+
+// CashRound rounds an electronic amount to what can be paid in cash where the smallest coins were withdrawn,
+// eg. to the nearest 5*Cent in Canada or Switzerland. It is Quantize under a name that keeps cash amounts
+// apart from exact ones.
+func CashRound(amount Micro, increment Micro, rounding byte) (Micro, error) {
+	return Quantize(amount, increment, rounding)
+}
+
+// MulRat returns amount * num / den rounded half away from zero. The intermediate product can't overflow.
+func MulRat(amount Micro, num int64, den int64) (Micro, error) {
+	return MulDiv(amount, num, den, RoundingHalfAwayFromZero)
+}
+
+// MulDiv returns amount * mul / div rounded with the given rounding mode. The intermediate product
+// can't overflow, so only a result outside of Micro's range returns ErrOverflow.
+func MulDiv(amount Micro, mul int64, div int64, rounding byte) (Micro, error) {
+	if div == 0 {
+		return 0, ErrZeroDivision
+	}
+
+	product := new(big.Int).Mul(big.NewInt(int64(amount)), big.NewInt(mul))
+	return divideBigAndRound(product, big.NewInt(div), rounding)
+}
+
+// MulMicro multiplies two amounts, eg. a price per unit and a fractional quantity, and rounds the product
+// back to micros with the given rounding mode. The intermediate product can't overflow.
+func MulMicro(a Micro, b Micro, rounding byte) (Micro, error) {
+	return MulDiv(a, int64(b), int64(precision), rounding)
+}
+
+// LineTotal returns the total of an invoice line, unitPrice * quantity, where quantity is a possibly fractional
+// number of units expressed as Micro, eg. 2.5 hours. It is MulMicro under a name that says what it's for.
+func LineTotal(unitPrice Micro, quantity Micro, rounding byte) (Micro, error) {
+	return MulMicro(unitPrice, quantity, rounding)
+}
+
+// FromBigRat converts an exact rational amount in dollars, eg. a computed tax fraction, to Micro, rounding
+// anything below a micro with the given rounding mode. Results that don't fit into Micro return ErrOverflow.
+func FromBigRat(r *big.Rat, rounding byte) (Micro, error) {
+	if r == nil {
+		return 0, ErrInvalidInput
+	}
+
+	scaled := new(big.Int).Mul(r.Num(), big.NewInt(int64(precision)))
+	return divideBigAndRound(scaled, r.Denom(), rounding)
+}
+
+// BigRat returns the exact amount in dollars as a reduced fraction, eg. 1/4 for 250000 micros.
+func (micro Micro) BigRat() *big.Rat {
+	return big.NewRat(int64(micro), int64(precision))
+}
+
+// Average returns the mean of amounts rounded with the given rounding mode. The sum can't overflow, so any
+// slice of amounts has an average.
+func Average(amounts []Micro, rounding byte) (Micro, error) {
+	if len(amounts) == 0 {
+		return 0, ErrInvalidInput
+	}
+
+	sum := new(big.Int)
+	for _, amount := range amounts {
+		sum.Add(sum, big.NewInt(int64(amount)))
+	}
+
+	return divideBigAndRound(sum, big.NewInt(int64(len(amounts))), rounding)
 }
@@ -1,14 +1,22 @@
 package money
 
 import (
+	"bytes"
+	"context"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 )
 
 type parseFloatStringTest struct {
@@ -17,6 +25,14 @@ type parseFloatStringTest struct {
 	err      error
 }
 
+type fromStringLocaleTest struct {
+	input        string
+	thousandsSep rune
+	decimalSep   rune
+	expected     Micro
+	err          error
+}
+
 type addTest struct {
 	input1   Micro
 	input2   Micro
@@ -39,14 +55,83 @@ type divTest struct {
 	err      error
 }
 
+type fromFloat64RoundingTest struct {
+	input    float64
+	rounding byte
+	expected Micro
+	err      error
+}
+
+type toStringFixedTest struct {
+	input    Micro
+	decimals int
+	expected string
+	err      error
+}
+
+type formatTest struct {
+	input        Micro
+	decimals     int
+	thousandsSep string
+	decimalSep   string
+	expected     string
+	err          error
+}
+
+type mulRatTest struct {
+	amount   Micro
+	num      int64
+	den      int64
+	expected Micro
+	err      error
+}
+
+type mulDivTest struct {
+	amount   Micro
+	mul      int64
+	div      int64
+	rounding byte
+	expected Micro
+	err      error
+}
+
+type scaleTest struct {
+	amount     Micro
+	powerOfTen int
+	expected   Micro
+	err        error
+}
+
+type roundTest struct {
+	amount   Micro
+	unit     Micro
+	rounding byte
+	expected Micro
+	err      error
+}
+
 var parseFloatStringTests = []parseFloatStringTest{
-	{"", Micro(0), ErrInvalidInput},
+	{"", Micro(0), ErrEmptyInput},
 	{"1", Dollar, nil},
 	{"+1", Dollar, nil},
 	{"1.1", 110 * Cent, nil},
 	{"000001.1", 110 * Cent, nil},
 	{"0.", Micro(0), nil},
 	{".1", 10 * Cent, nil},
+	{"5.", 5 * Dollar, nil},
+	{"-5.", -5 * Dollar, nil},
+	{"-.5", -50 * Cent, nil},
+	{"+.5", 50 * Cent, nil},
+	{"+5.", 5 * Dollar, nil},
+	{"+1.5e3", 0, ErrInvalidInput},
+	{"+-1", 0, ErrInvalidInput},
+	{"++1", 0, ErrInvalidInput},
+	{".", 0, ErrInvalidInput},
+	{"-.", 0, ErrInvalidInput},
+	{"+.", 0, ErrInvalidInput},
+	{"-", 0, ErrInvalidInput},
+	{"+", 0, ErrInvalidInput},
+	{"..", 0, ErrInvalidInput},
 	{"0.1e10", 0, ErrInvalidInput},
 	{"0.1E10", 0, ErrInvalidInput},
 	{".1e10", 0, ErrInvalidInput},
@@ -76,8 +161,8 @@ var parseFloatStringTests = []parseFloatStringTest{
 	{"Infinity", 0, ErrInvalidInput},
 
 	// largest money
-	{"9000000000.000000", 9000000000000000, nil},
-	{"-9000000000.000000", -9000000000000000, nil},
+	{"9000000000.000000", MaxAmount, nil},
+	{"-9000000000.000000", MinAmount, nil},
 	// too large
 	{"9223372036854.775808", 0, ErrOverflow},
 	{"-9223372036854.775809", 0, ErrOverflow},
@@ -112,12 +197,156 @@ var parseFloatStringTests = []parseFloatStringTest{
 	// max int64 + 1 for overflow
 	{"9223372036854.775808", 0, ErrOverflow},
 	{"-9223372036854.775809", 0, ErrOverflow},
+	// fits into uint64 micros, but not into int64 ones once padded with zeros
+	{"9223372036855", 0, ErrOverflow},
+	{"-9223372036855", 0, ErrOverflow},
+	{"9300000000000", 0, ErrOverflow},
+	{"-9300000000000.5", 0, ErrOverflow},
+	{"18446744073709", 0, ErrOverflow},
+	{"9223372036854", 9223372036854000000, nil},
+	{"-9223372036854.7758", -9223372036854775800, nil},
 	// a number that overflows but seemingly stops overflowing on the last iteration
 	{"92233720368547758087", 0, ErrOverflow},
 	// a huge number
 	{"100000000000000011102230246251565404236316680908203125" + strings.Repeat("0", 10000) + "1", 0, ErrOverflow},
 }
 
+var fromStringGroupedTests = []parseFloatStringTest{
+	{"1_000_000.50", 1000000*Dollar + 50*Cent, nil},
+	{"-1_000_000.50", -1000000*Dollar - 50*Cent, nil},
+	{"+1_000", 1000 * Dollar, nil},
+	{"1_0_0", 100 * Dollar, nil},
+	{"0.123_456", Micro(123456), nil},
+	{"1000000.50", 1000000*Dollar + 50*Cent, nil},
+	{"9_223_372_036_854.775_807", Micro(math.MaxInt64), nil},
+	{"9_223_372_036_854.775_808", 0, ErrOverflow},
+
+	{"_1000", 0, ErrInvalidInput},
+	{"1000_", 0, ErrInvalidInput},
+	{"1__000", 0, ErrInvalidInput},
+	{"-_1000", 0, ErrInvalidInput},
+	{"1_.5", 0, ErrInvalidInput},
+	{"1._5", 0, ErrInvalidInput},
+	{"_", 0, ErrInvalidInput},
+	{"1_x00", 0, ErrInvalidInput},
+	{"", 0, ErrEmptyInput},
+}
+
+var fromStringLocaleTests = []fromStringLocaleTest{
+	{"1.234,56", '.', ',', 123456 * Cent, nil},
+	{"-1.234,56", '.', ',', -123456 * Cent, nil},
+	{"1.234.567,891", '.', ',', Micro(1234567891000), nil},
+	{"1234,56", '.', ',', 123456 * Cent, nil},
+	{"1.234", '.', ',', 1234 * Dollar, nil},
+	{"0,5", '.', ',', 50 * Cent, nil},
+	{",5", '.', ',', 50 * Cent, nil},
+	{"1,234.56", ',', '.', 123456 * Cent, nil},
+	{"1 234 567,5", ' ', ',', Micro(1234567500000), nil},
+	{"1'234.5", '\'', '.', Micro(1234500000), nil},
+	{"1\u00a0234,5", '\u00a0', ',', Micro(1234500000), nil},
+
+	// inconsistent separators
+	{"1,234.56", '.', ',', 0, ErrInvalidInput},
+	{"1.23,56", '.', ',', 0, ErrInvalidInput},
+	{"1.2345,6", '.', ',', 0, ErrInvalidInput},
+	{"12345.678,9", '.', ',', 0, ErrInvalidInput},
+	{"1.234,567.8", '.', ',', 0, ErrInvalidInput},
+	{"1..234", '.', ',', 0, ErrInvalidInput},
+	{".234,5", '.', ',', 0, ErrInvalidInput},
+	{"-.234", '.', ',', 0, ErrInvalidInput},
+	{"1.234.", '.', ',', 0, ErrInvalidInput},
+	{"1,234,5", '.', ',', 0, ErrInvalidInput},
+	{"1 234.5", ' ', ',', 0, ErrInvalidInput},
+	{"1.234,56", '.', '.', 0, ErrInvalidInput},
+
+	// a leading zero can't be followed by a thousands group
+	{"0.500", '.', ',', 0, ErrInvalidInput},
+	{"00.123", '.', ',', 0, ErrInvalidInput},
+	{"-0.500,5", '.', ',', 0, ErrInvalidInput},
+	{"0,500", '.', ',', 50 * Cent, nil},
+	{"1.000", '.', ',', 1000 * Dollar, nil},
+	{"1.234,5x", '.', ',', 0, ErrInvalidInput},
+	{"", '.', ',', 0, ErrEmptyInput},
+}
+
+var fromStringLenientTests = []parseFloatStringTest{
+	{" $1,234.50 ", 123450 * Cent, nil},
+	{"1 234,50 kr", 123450 * Cent, nil},
+	{"€5", 5 * Dollar, nil},
+	{"5,00 €", 5 * Dollar, nil},
+	{"-$5.00", -5 * Dollar, nil},
+	{"$-5.00", -5 * Dollar, nil},
+	{"- 5.00 USD", -5 * Dollar, nil},
+	{"USD 1,234,567", 1234567 * Dollar, nil},
+	{"1.234.567,89 EUR", 123456789 * Cent, nil},
+	{"\t1,234\n", 1234 * Dollar, nil},
+	{"0.125", Micro(125000), nil},
+	{"0,125", Micro(125000), nil},
+	{"00,123", Micro(123000), nil},
+	{"-0,500", -50 * Cent, nil},
+	{"0.500.000", 0, ErrInvalidInput},
+	{"0,500,000", 0, ErrInvalidInput},
+	{"0 500,25", 0, ErrInvalidInput},
+	{"01,234.5", 0, ErrInvalidInput},
+	{"12,5", 1250 * Cent, nil},
+	{"1'234.5 CHF", Micro(1234500000), nil},
+	{"1234.567891", Micro(1234567891), nil},
+
+	// accounting negatives
+	{"(5.00)", -5 * Dollar, nil},
+	{" (1,234.56) ", -123456 * Cent, nil},
+	{"($1,234.56)", -123456 * Cent, nil},
+	{"( 5.00 EUR )", -5 * Dollar, nil},
+	{"(0)", 0, nil},
+	{"(5.00", 0, ErrInvalidInput},
+	{"5.00)", 0, ErrInvalidInput},
+	{"(", 0, ErrInvalidInput},
+	{")", 0, ErrInvalidInput},
+	{"()", 0, ErrInvalidInput},
+	{"(-5.00)", 0, ErrInvalidInput},
+	{"((5.00))", 0, ErrInvalidInput},
+	{"$(5.00)", 0, ErrInvalidInput},
+
+	{"", 0, ErrEmptyInput},
+	{"   ", 0, ErrEmptyInput},
+	{"$", 0, ErrInvalidInput},
+	{"abc", 0, ErrInvalidInput},
+	{"$1x2", 0, ErrInvalidInput},
+	{"$1-2", 0, ErrInvalidInput},
+	{"--5", 0, ErrInvalidInput},
+	{"$5 $5", 0, ErrInvalidInput},
+
+	// thousands separators have to separate groups of three digits
+	{"1.2.3", 0, ErrInvalidInput},
+	{"12,34,5", 0, ErrInvalidInput},
+	{"1,,000", 0, ErrInvalidInput},
+	{"1,000,00", 0, ErrInvalidInput},
+	{"1234,567", 0, ErrInvalidInput},
+	{",000", 0, ErrInvalidInput},
+	{"1,234 567", 0, ErrInvalidInput},
+	{"1 234.5,6", 0, ErrInvalidInput},
+	{"1,234.567,8", 0, ErrInvalidInput},
+
+	// currency codes have at most three letters
+	{"5 apples", 0, ErrInvalidInput},
+	{"dollars 5", 0, ErrInvalidInput},
+	{"US$5", 5 * Dollar, nil},
+	{"5 zł", 5 * Dollar, nil},
+	{"5 EUR", 5 * Dollar, nil},
+
+	// an incomplete exponent isn't a currency
+	{"1e", 0, ErrInvalidInput},
+	{"5e", 0, ErrInvalidInput},
+	{"5E", 0, ErrInvalidInput},
+	{"e5", 0, ErrInvalidInput},
+
+	// a currency either before or after the number
+	{"$5$", 0, ErrInvalidInput},
+	{"kr5kr", 0, ErrInvalidInput},
+	{"USD 5 USD", 0, ErrInvalidInput},
+	{"(€5 €)", 0, ErrInvalidInput},
+}
+
 var addTests = []addTest{
 	{Micro(0), Micro(0), Micro(0), nil},
 	{Micro(0), Micro(1), Micro(1), nil},
@@ -134,6 +363,24 @@ var addTests = []addTest{
 	{Micro(math.MinInt64), Micro(0), Micro(math.MinInt64), nil},
 }
 
+var subTests = []addTest{
+	{Micro(0), Micro(0), Micro(0), nil},
+	{Micro(0), Micro(1), Micro(-1), nil},
+	{Micro(1), Micro(0), Micro(1), nil},
+	{Micro(0), Micro(-1), Micro(1), nil},
+	{Micro(-1), Micro(0), Micro(-1), nil},
+	{Micro(-1), Micro(-1), Micro(0), nil},
+
+	{Micro(math.MaxInt64), Micro(math.MaxInt64), 0, nil},
+	{Micro(math.MaxInt64), Micro(-1), 0, ErrOverflow},
+	{Micro(0), Micro(math.MinInt64), 0, ErrOverflow},
+	{Micro(-1), Micro(math.MinInt64), Micro(math.MaxInt64), nil},
+
+	{Micro(math.MinInt64), Micro(math.MinInt64), 0, nil},
+	{Micro(math.MinInt64), Micro(1), 0, ErrOverflow},
+	{Micro(math.MinInt64), Micro(0), Micro(math.MinInt64), nil},
+}
+
 var mulTests = []mulTest{
 	{Micro(0), 0, Micro(0), nil},
 	{Micro(0), 1, Micro(0), nil},
@@ -162,6 +409,10 @@ var divTests = []divTest{
 	{Micro(-1), -1, RoundingNone, Micro(1), nil},
 
 	{Micro(math.MaxInt64), 1, RoundingNone, Micro(math.MaxInt64), nil},
+	{Micro(math.MinInt64), -1, RoundingNone, Micro(0), ErrOverflow},
+	{Micro(math.MinInt64), -1, RoundingCeil, Micro(0), ErrOverflow},
+	{Micro(math.MinInt64), -1, RoundingHalfDown, Micro(0), ErrOverflow},
+	{Micro(math.MinInt64), 1, RoundingFloor, Micro(math.MinInt64), nil},
 	{Micro(1000000), 2, RoundingNone, Micro(500000), nil},
 	{Micro(1000000), 3, RoundingNone, Micro(333333), nil},
 	{Micro(2000000), 3, RoundingNone, Micro(666666), nil},
@@ -193,6 +444,300 @@ var divTests = []divTest{
 	{Micro(-11), -7, RoundingHalfAwayFromZero, Micro(2), nil},
 	{Micro(-11), -2, RoundingHalfAwayFromZero, Micro(6), nil},
 	{Micro(-12), -2, RoundingHalfAwayFromZero, Micro(6), nil},
+	{Micro(math.MaxInt64), 2, RoundingHalfAwayFromZero, Micro(math.MaxInt64/2 + 1), nil},
+	{Micro(math.MinInt64), 3, RoundingHalfAwayFromZero, Micro(math.MinInt64/3 - 1), nil},
+	{Micro(math.MinInt64), math.MinInt64, RoundingHalfAwayFromZero, Micro(1), nil},
+	{Micro(math.MaxInt64), math.MinInt64, RoundingHalfAwayFromZero, Micro(-1), nil},
+	// adding half of the divisor first used to wrap around
+	{Micro(math.MaxInt64), -2, RoundingHalfAwayFromZero, Micro(-math.MaxInt64/2 - 1), nil},
+	{Micro(math.MinInt64 + 1), 2, RoundingHalfAwayFromZero, Micro(math.MinInt64 / 2), nil},
+	{Micro(math.MinInt64 + 1), -2, RoundingHalfAwayFromZero, Micro(math.MaxInt64/2 + 1), nil},
+
+	{Micro(1), 3, RoundingCeil, Micro(1), nil},
+	{Micro(-1), 3, RoundingCeil, Micro(0), nil},
+	{Micro(1), -3, RoundingCeil, Micro(0), nil},
+	{Micro(-1), -3, RoundingCeil, Micro(1), nil},
+	{Micro(7), 2, RoundingCeil, Micro(4), nil},
+	{Micro(-7), 2, RoundingCeil, Micro(-3), nil},
+	{Micro(7), -2, RoundingCeil, Micro(-3), nil},
+	{Micro(-7), -2, RoundingCeil, Micro(4), nil},
+	{Micro(6), 2, RoundingCeil, Micro(3), nil},
+	{Micro(-6), 2, RoundingCeil, Micro(-3), nil},
+
+	{Micro(1), 3, RoundingFloor, Micro(0), nil},
+	{Micro(-1), 3, RoundingFloor, Micro(-1), nil},
+	{Micro(1), -3, RoundingFloor, Micro(-1), nil},
+	{Micro(-1), -3, RoundingFloor, Micro(0), nil},
+	{Micro(7), 2, RoundingFloor, Micro(3), nil},
+	{Micro(-7), 2, RoundingFloor, Micro(-4), nil},
+	{Micro(7), -2, RoundingFloor, Micro(-4), nil},
+	{Micro(-7), -2, RoundingFloor, Micro(3), nil},
+	{Micro(6), 2, RoundingFloor, Micro(3), nil},
+	{Micro(-6), 2, RoundingFloor, Micro(-3), nil},
+
+	{Micro(7), 2, RoundingTowardZero, Micro(7 / 2), nil},
+	{Micro(-7), 2, RoundingTowardZero, Micro(-7 / 2), nil},
+	{Micro(7), -2, RoundingTowardZero, Micro(7 / -2), nil},
+	{Micro(-7), -2, RoundingTowardZero, Micro(-7 / -2), nil},
+	{Micro(-7), 2, RoundingTowardZero, Micro(-3), nil},
+	{Micro(2000000), 3, RoundingTowardZero, Micro(666666), nil},
+	{Micro(1), 0, RoundingTowardZero, Micro(0), ErrZeroDivision},
+
+	{Micro(1), 3, 255, Micro(0), ErrUnsupportedRounding},
+}
+
+// halfTieDivTests isolate how the half rounding modes break ties, which only differ for ties of one sign.
+var halfTieDivTests = []divTest{
+	// negative ties
+	{Micro(-5), 2, RoundingHalfAwayFromZero, Micro(-3), nil},
+	{Micro(-5), 2, RoundingHalfUp, Micro(-2), nil},
+	{Micro(-5), 2, RoundingHalfDown, Micro(-3), nil},
+	{Micro(5), -2, RoundingHalfAwayFromZero, Micro(-3), nil},
+	{Micro(5), -2, RoundingHalfUp, Micro(-2), nil},
+	{Micro(5), -2, RoundingHalfDown, Micro(-3), nil},
+	{Micro(-1500), 1000, RoundingHalfAwayFromZero, Micro(-2), nil},
+	{Micro(-1500), 1000, RoundingHalfUp, Micro(-1), nil},
+	{Micro(-1500), 1000, RoundingHalfDown, Micro(-2), nil},
+	{Micro(-1), 2, RoundingHalfAwayFromZero, Micro(-1), nil},
+	{Micro(-1), 2, RoundingHalfUp, Micro(0), nil},
+	{Micro(-1), 2, RoundingHalfDown, Micro(-1), nil},
+
+	// positive ties
+	{Micro(5), 2, RoundingHalfAwayFromZero, Micro(3), nil},
+	{Micro(5), 2, RoundingHalfUp, Micro(3), nil},
+	{Micro(5), 2, RoundingHalfDown, Micro(2), nil},
+	{Micro(-5), -2, RoundingHalfUp, Micro(3), nil},
+	{Micro(-5), -2, RoundingHalfDown, Micro(2), nil},
+
+	// no tie, all half modes round to the nearest
+	{Micro(-1499), 1000, RoundingHalfUp, Micro(-1), nil},
+	{Micro(-1501), 1000, RoundingHalfUp, Micro(-2), nil},
+	{Micro(1499), 1000, RoundingHalfDown, Micro(1), nil},
+	{Micro(1501), 1000, RoundingHalfDown, Micro(2), nil},
+	{Micro(-11), 7, RoundingHalfUp, Micro(-2), nil},
+	{Micro(11), 7, RoundingHalfDown, Micro(2), nil},
+	{Micro(math.MaxInt64), 2, RoundingHalfDown, Micro(math.MaxInt64 / 2), nil},
+	{Micro(math.MinInt64), 3, RoundingHalfUp, Micro(math.MinInt64/3 - 1), nil},
+}
+
+var fromFloat64RoundingTests = []fromFloat64RoundingTest{
+	// 0.0000025 is exactly 2.5 micros after scaling
+	{0.0000025, RoundingNone, Micro(2), nil},
+	{-0.0000025, RoundingNone, Micro(-2), nil},
+	{0.0000025, RoundingTowardZero, Micro(2), nil},
+	{-0.0000025, RoundingTowardZero, Micro(-2), nil},
+	{0.0000025, RoundingHalfAwayFromZero, Micro(3), nil},
+	{-0.0000025, RoundingHalfAwayFromZero, Micro(-3), nil},
+	{0.0000025, RoundingCeil, Micro(3), nil},
+	{-0.0000025, RoundingCeil, Micro(-2), nil},
+	{0.0000025, RoundingFloor, Micro(2), nil},
+	{-0.0000025, RoundingFloor, Micro(-3), nil},
+	{0.0000025, RoundingHalfUp, Micro(3), nil},
+	{-0.0000025, RoundingHalfUp, Micro(-2), nil},
+	{0.0000025, RoundingHalfDown, Micro(2), nil},
+	{-0.0000025, RoundingHalfDown, Micro(-3), nil},
+	{0.0000026, RoundingHalfDown, Micro(3), nil},
+	{-0.0000024, RoundingHalfUp, Micro(-2), nil},
+
+	{1.5, RoundingFloor, Micro(1500000), nil},
+	{0.0000025, 255, Micro(0), ErrUnsupportedRounding},
+	{13849502840392485906123.764538, RoundingHalfAwayFromZero, Micro(0), ErrOverBounds},
+	{-13849502840392485906123.764538, RoundingFloor, Micro(0), ErrOverBounds},
+
+	// the smallest float64 above 9e9 dollars is out of bounds even when rounded down
+	{9000000000.0000019, RoundingFloor, Micro(0), ErrOverBounds},
+	{-9000000000.0000019, RoundingCeil, Micro(0), ErrOverBounds},
+
+	// decimal ties whose float64 is slightly below the tie and whose scaled product rounds the wrong way
+	{0.2512735, RoundingHalfAwayFromZero, Micro(251274), nil},
+	{-0.2512735, RoundingHalfAwayFromZero, Micro(-251274), nil},
+	{264.1140445, RoundingHalfAwayFromZero, Micro(264114045), nil},
+	{264.1140445, RoundingHalfUp, Micro(264114045), nil},
+	{-264.1140445, RoundingHalfUp, Micro(-264114044), nil},
+	{264.1140445, RoundingHalfDown, Micro(264114044), nil},
+	{-264.1140445, RoundingHalfDown, Micro(-264114045), nil},
+	{1.0000005, RoundingHalfAwayFromZero, Micro(1000001), nil},
+	{-1.0000005, RoundingHalfUp, Micro(-1000000), nil},
+	{1.0000005, RoundingNone, Micro(1000000), nil},
+	{1.0000005, RoundingCeil, Micro(1000001), nil},
+	{-1.0000005, RoundingFloor, Micro(-1000001), nil},
+	{1.0000005, RoundingFloor, Micro(1000000), nil},
+	// just off the tie, and whole micros that must not be nudged by ceil or floor
+	{1.00000050001, RoundingHalfDown, Micro(1000001), nil},
+	{1.00000049999, RoundingHalfUp, Micro(1000000), nil},
+	{0.000001, RoundingCeil, Micro(1), nil},
+	{-0.000001, RoundingFloor, Micro(-1), nil},
+	{8999999999.999998, RoundingCeil, Micro(8999999999999998), nil},
+	{1e-300, RoundingCeil, Micro(1), nil},
+	{-1e-300, RoundingCeil, Micro(0), nil},
+	{-1e-300, RoundingHalfAwayFromZero, Micro(0), nil},
+}
+
+var toStringFixedTests = []toStringFixedTest{
+	{8 * Dollar, 2, "8.00", nil},
+	{801 * Cent, 2, "8.01", nil},
+	{8 * Cent, 2, "0.08", nil},
+	{Micro(0), 2, "0.00", nil},
+	{-8 * Dollar, 2, "-8.00", nil},
+	{-801 * Cent, 2, "-8.01", nil},
+	{-8 * Cent, 2, "-0.08", nil},
+
+	// rounding from 6 down to 2 places
+	{Micro(1234567), 2, "1.23", nil},
+	{Micro(1235000), 2, "1.24", nil},
+	{Micro(1234999), 2, "1.23", nil},
+	{Micro(-1235000), 2, "-1.24", nil},
+	{Micro(-1234999), 2, "-1.23", nil},
+	{Micro(999999), 2, "1.00", nil},
+	{Micro(-5000), 2, "-0.01", nil},
+	{Micro(-4999), 2, "0.00", nil},
+	{Micro(2500000), 0, "3", nil},
+	{Micro(-2500000), 0, "-3", nil},
+	{Micro(1234567), 5, "1.23457", nil},
+
+	// padding
+	{Micro(1234567), 6, "1.234567", nil},
+	{Micro(-1234567), 6, "-1.234567", nil},
+	{Micro(1234567), 8, "1.23456700", nil},
+	{Micro(-1), 7, "-0.0000010", nil},
+
+	{Micro(math.MaxInt64), 6, "9223372036854.775807", nil},
+	{Micro(math.MinInt64), 6, "-9223372036854.775808", nil},
+	{Micro(math.MaxInt64), 2, "", ErrOverflow},
+	{Micro(1234567), -1, "", ErrInvalidInput},
+}
+
+var formatTests = []formatTest{
+	{Micro(1234567890000), 2, ",", ".", "1,234,567.89", nil},
+	{Micro(-1234567890000), 2, ",", ".", "-1,234,567.89", nil},
+	{Micro(1234567890000), 2, ".", ",", "1.234.567,89", nil},
+	{Micro(-1234567890000), 2, ".", ",", "-1.234.567,89", nil},
+	{Micro(1234567890000), 0, " ", ",", "1 234 568", nil},
+	{Micro(123456789000), 2, ",", ".", "123,456.79", nil},
+	{Micro(-123456789000), 3, ",", ".", "-123,456.789", nil},
+	{Micro(999990000), 2, ",", ".", "999.99", nil},
+	{Micro(999995000), 2, ",", ".", "1,000.00", nil},
+	{-5 * Cent, 2, ",", ".", "-0.05", nil},
+	{Micro(0), 2, ",", ".", "0.00", nil},
+	{Micro(math.MinInt64), 6, "'", ".", "-9'223'372'036'854.775808", nil},
+	{Micro(1234567890000), -1, ",", ".", "", ErrInvalidInput},
+}
+
+var roundTests = []roundTest{
+	{Micro(12345678), Cent, RoundingHalfAwayFromZero, Micro(12350000), nil},
+	{Micro(12345678), Cent, RoundingNone, Micro(12340000), nil},
+	{Micro(12345678), Cent, RoundingFloor, Micro(12340000), nil},
+	{Micro(12345678), Cent, RoundingCeil, Micro(12350000), nil},
+	{Micro(-12345678), Cent, RoundingHalfAwayFromZero, Micro(-12350000), nil},
+	{Micro(-12345678), Cent, RoundingFloor, Micro(-12350000), nil},
+	{Micro(-12345678), Cent, RoundingCeil, Micro(-12340000), nil},
+
+	// ties
+	{Micro(1005000), Cent, RoundingHalfAwayFromZero, Micro(1010000), nil},
+	{Micro(-1005000), Cent, RoundingHalfAwayFromZero, Micro(-1010000), nil},
+	{Micro(1005000), Cent, RoundingTowardZero, Micro(1000000), nil},
+	{Micro(2500000), Dollar, RoundingHalfAwayFromZero, 3 * Dollar, nil},
+	{Micro(-2500000), Dollar, RoundingHalfAwayFromZero, -3 * Dollar, nil},
+	{Micro(2499999), Dollar, RoundingHalfAwayFromZero, 2 * Dollar, nil},
+	{Micro(2500000), Dollar, RoundingFloor, 2 * Dollar, nil},
+	{Micro(-2500000), Dollar, RoundingCeil, -2 * Dollar, nil},
+
+	{Micro(0), Dollar, RoundingHalfAwayFromZero, Micro(0), nil},
+	{8 * Dollar, Cent, RoundingHalfAwayFromZero, 8 * Dollar, nil},
+
+	{Micro(math.MaxInt64), Dollar, RoundingCeil, Micro(0), ErrOverflow},
+	{Micro(12345678), Micro(0), RoundingHalfAwayFromZero, Micro(0), ErrInvalidInput},
+	{Micro(12345678), -Cent, RoundingHalfAwayFromZero, Micro(0), ErrInvalidInput},
+	{Micro(12345678), Cent, 255, Micro(0), ErrUnsupportedRounding},
+}
+
+var quantizeTests = []roundTest{
+	// snapping up and down to a grid of 5 cents
+	{Micro(1234567), 5 * Cent, RoundingHalfAwayFromZero, Micro(1250000), nil},
+	{Micro(1220000), 5 * Cent, RoundingHalfAwayFromZero, Micro(1200000), nil},
+	{Micro(-1234567), 5 * Cent, RoundingHalfAwayFromZero, Micro(-1250000), nil},
+	{Micro(1234567), 5 * Cent, RoundingFloor, Micro(1200000), nil},
+	{Micro(1220000), 5 * Cent, RoundingCeil, Micro(1250000), nil},
+
+	// ties
+	{Micro(1225000), 5 * Cent, RoundingHalfAwayFromZero, Micro(1250000), nil},
+	{Micro(-1225000), 5 * Cent, RoundingHalfAwayFromZero, Micro(-1250000), nil},
+	{Micro(1225000), 5 * Cent, RoundingTowardZero, Micro(1200000), nil},
+
+	// odd steps
+	{Micro(100), Micro(7), RoundingHalfAwayFromZero, Micro(98), nil},
+	{Micro(1200000), Micro(1200000), RoundingHalfAwayFromZero, Micro(1200000), nil},
+
+	{Micro(1234567), Micro(0), RoundingHalfAwayFromZero, Micro(0), ErrInvalidInput},
+	{Micro(1234567), -5 * Cent, RoundingHalfAwayFromZero, Micro(0), ErrInvalidInput},
+}
+
+var mulRatTests = []mulRatTest{
+	{Dollar, 1, 3, Micro(333333), nil},
+	{Dollar, 2, 3, Micro(666667), nil},
+	{-Dollar, 2, 3, Micro(-666667), nil},
+	{Dollar, -2, 3, Micro(-666667), nil},
+	{Dollar, 2, -3, Micro(-666667), nil},
+	{-Dollar, -2, -3, Micro(-666667), nil},
+	{Micro(5), 1, 2, Micro(3), nil},
+	{Micro(-5), 1, 2, Micro(-3), nil},
+	{Micro(0), 1, 3, Micro(0), nil},
+
+	// the intermediate product overflows int64, but the result fits
+	{Micro(math.MaxInt64), 1000, 1000, Micro(math.MaxInt64), nil},
+	{Micro(math.MinInt64), math.MaxInt64, math.MaxInt64, Micro(math.MinInt64), nil},
+	{9000000000 * Dollar, 1000000, 3000000, 3000000000 * Dollar, nil},
+
+	{Micro(math.MaxInt64), 2, 1, Micro(0), ErrOverflow},
+	{Micro(math.MinInt64), -1, 1, Micro(0), ErrOverflow},
+	{Dollar, 1, 0, Micro(0), ErrZeroDivision},
+}
+
+var mulDivTests = []mulDivTest{
+	{100 * Dollar, 1, 3, RoundingNone, Micro(33333333), nil},
+	{100 * Dollar, 2, 3, RoundingNone, Micro(66666666), nil},
+	{100 * Dollar, 2, 3, RoundingHalfAwayFromZero, Micro(66666667), nil},
+	{-100 * Dollar, 2, 3, RoundingHalfAwayFromZero, Micro(-66666667), nil},
+	{-100 * Dollar, 2, 3, RoundingTowardZero, Micro(-66666666), nil},
+	{100 * Dollar, 1, 3, RoundingCeil, Micro(33333334), nil},
+	{-100 * Dollar, 1, 3, RoundingCeil, Micro(-33333333), nil},
+	{100 * Dollar, 1, 3, RoundingFloor, Micro(33333333), nil},
+	{-100 * Dollar, 1, 3, RoundingFloor, Micro(-33333334), nil},
+	{Micro(6), 1, 4, RoundingHalfAwayFromZero, Micro(2), nil},
+	{Micro(6), 1, -4, RoundingHalfAwayFromZero, Micro(-2), nil},
+	{Micro(7), 3, 7, RoundingFloor, Micro(3), nil},
+
+	// 9 billion dollars * 7 overflows int64 on its own
+	{9000000000 * Dollar, 7, 10, RoundingNone, 6300000000 * Dollar, nil},
+	{Micro(math.MaxInt64), math.MaxInt64, math.MaxInt64, RoundingNone, Micro(math.MaxInt64), nil},
+	{Micro(math.MaxInt64), 3, 2, RoundingNone, Micro(0), ErrOverflow},
+
+	{Dollar, 1, 0, RoundingNone, Micro(0), ErrZeroDivision},
+	{Dollar, 1, 3, 255, Micro(0), ErrUnsupportedRounding},
+	{Dollar, 3, 3, 255, Micro(0), ErrUnsupportedRounding},
+}
+
+var scaleTests = []scaleTest{
+	{Micro(1250000), 0, Micro(1250000), nil},
+	{Micro(1250000), 1, Micro(12500000), nil},
+	{Micro(1250000), 3, Micro(1250000000), nil},
+	{Micro(-1250000), 3, Micro(-1250000000), nil},
+	{Micro(1250000), -3, Micro(1250), nil},
+	{Micro(1250000), -6, Micro(1), nil},
+	{Micro(1500000), -6, Micro(2), nil},
+	{Micro(1499999), -6, Micro(1), nil},
+	{Micro(-1500000), -6, Micro(-2), nil},
+	{Micro(1250000), -7, Micro(0), nil},
+	{Micro(math.MaxInt64), -19, Micro(1), nil},
+	{Micro(math.MinInt64), -19, Micro(-1), nil},
+	{Micro(math.MaxInt64), -20, Micro(0), nil},
+	{Micro(math.MaxInt64), math.MinInt32, Micro(0), nil},
+	{Micro(0), math.MaxInt32, Micro(0), nil},
+
+	{Micro(922337203685477580), 1, Micro(9223372036854775800), nil},
+	{Micro(922337203685477581), 1, 0, ErrOverflow},
+	{Micro(1), 19, 0, ErrOverflow},
+	{Micro(-1), math.MaxInt32, 0, ErrOverflow},
 }
 
 func TestMoneyTestSuite(t *testing.T) {
@@ -240,6 +785,31 @@ func (suite *MoneyTestSuite) TestMarshalJSON() {
 	suite.Equal("-8.01", string(result))
 }
 
+func (suite *MoneyTestSuite) TestMarshalJSONOverBounds() {
+	result, err := MaxAmount.MarshalJSON()
+	suite.Nil(err)
+	suite.Equal("9000000000", string(result))
+
+	result, err = MinAmount.MarshalJSON()
+	suite.Nil(err)
+	suite.Equal("-9000000000", string(result))
+
+	result, err = (MaxAmount + 1).MarshalJSON()
+	suite.Equal(ErrOverBounds, err)
+	suite.Nil(result)
+
+	result, err = (MinAmount - 1).MarshalJSON()
+	suite.Equal(ErrOverBounds, err)
+	suite.Nil(result)
+
+	_, err = json.Marshal(struct{ Budget Micro }{MaxMicro})
+	suite.True(errors.Is(err, ErrOverBounds))
+
+	result, err = (MaxAmount + 1).MarshalText()
+	suite.Equal(ErrOverBounds, err)
+	suite.Nil(result)
+}
+
 func (suite *MoneyTestSuite) TestUnmarshalJSON() {
 	var mNil *Micro
 	err := mNil.UnmarshalJSON(nil)
@@ -256,6 +826,11 @@ func (suite *MoneyTestSuite) TestUnmarshalJSON() {
 	suite.Nil(err)
 	suite.Equal(Micro(100), m)
 
+	m = Micro(100)
+	err = (&m).UnmarshalJSON([]byte("null"))
+	suite.Nil(err)
+	suite.Equal(Micro(100), m)
+
 	m = Micro(0)
 	err = (&m).UnmarshalJSON([]byte("0.08"))
 	suite.Nil(err)
@@ -287,6 +862,24 @@ func (suite *MoneyTestSuite) TestUnmarshalJSON() {
 	suite.Equal(-801*Cent, m)
 }
 
+func (suite *MoneyTestSuite) TestUnmarshalJSONNullField() {
+	var result struct {
+		Budget Micro  `json:"budget"`
+		Spend  *Micro `json:"spend"`
+	}
+	result.Budget = 5 * Dollar
+
+	err := json.Unmarshal([]byte(`{"budget": null, "spend": null}`), &result)
+	suite.Nil(err)
+	suite.Equal(5*Dollar, result.Budget)
+	suite.Equal((*Micro)(nil), result.Spend)
+
+	err = json.Unmarshal([]byte(`{"budget": 8.01, "spend": "0.08"}`), &result)
+	suite.Nil(err)
+	suite.Equal(801*Cent, result.Budget)
+	suite.Equal(8*Cent, *result.Spend)
+}
+
 func (suite *MoneyTestSuite) TestInvalidUnmarshalJSON() {
 	m := Micro(0)
 	err := (&m).UnmarshalJSON([]byte("9223372036854.775808"))
@@ -299,222 +892,2222 @@ func (suite *MoneyTestSuite) TestInvalidUnmarshalJSON() {
 	suite.Equal(Micro(10), m)
 }
 
-func (suite *MoneyTestSuite) TestValidFromString() {
-	result, err := FromString("123.764538")
+func (suite *MoneyTestSuite) TestStringMicroJSON() {
+	result, err := json.Marshal(StringMicro(801 * Cent))
 	suite.Nil(err)
-	suite.Equal(Micro(123764538), result)
+	suite.Equal(`"8.01"`, string(result))
 
-	result, err = FromString("123.52348976")
+	result, err = json.Marshal(StringMicro(-8 * Dollar))
 	suite.Nil(err)
-	suite.Equal(Micro(123523490), result)
+	suite.Equal(`"-8"`, string(result))
 
-	result, err = FromString("0000123.523489")
+	result, err = json.Marshal(StringMicro(MaxAmount))
 	suite.Nil(err)
-	suite.Equal(Micro(123523489), result)
+	suite.Equal(`"9000000000"`, string(result))
 
-	result, err = FromString("123")
-	suite.Nil(err)
-	suite.Equal(Micro(123000000), result)
+	result, err = StringMicro(MaxAmount + 1).MarshalJSON()
+	suite.Equal(ErrOverBounds, err)
+	suite.Nil(result)
 
-	result, err = FromString("12.3")
+	var m StringMicro
+	err = json.Unmarshal([]byte(`"8.01"`), &m)
 	suite.Nil(err)
-	suite.Equal(Micro(12300000), result)
+	suite.Equal(StringMicro(801*Cent), m)
 
-	result, err = FromString("-12")
+	err = json.Unmarshal([]byte(`"9223372036854.775807"`), &m)
 	suite.Nil(err)
-	suite.Equal(Micro(-12000000), result)
+	suite.Equal(StringMicro(MaxMicro), m)
 
-	result, err = FromString("-12.3")
+	// bare numbers are still accepted
+	err = json.Unmarshal([]byte(`-8.01`), &m)
 	suite.Nil(err)
-	suite.Equal(Micro(-12300000), result)
-}
+	suite.Equal(StringMicro(-801*Cent), m)
 
-func (suite *MoneyTestSuite) TestInvalidFromString() {
-	result, err := FromString("123.764.538")
+	err = json.Unmarshal([]byte(`"8.01x"`), &m)
 	suite.Equal(ErrInvalidInput, err)
-	suite.Equal(Micro(0), result)
+}
 
-	result, err = FromString("123.7 64538")
-	suite.Equal(ErrInvalidInput, err)
-	suite.Equal(Micro(0), result)
+func (suite *MoneyTestSuite) TestFixedMicroJSON() {
+	result, err := json.Marshal(FixedMicro(8 * Dollar))
+	suite.Nil(err)
+	suite.Equal("8.00", string(result))
 
-	result, err = FromString("a123.764538")
-	suite.Equal(ErrInvalidInput, err)
-	suite.Equal(Micro(0), result)
+	result, err = json.Marshal(FixedMicro(801 * Cent))
+	suite.Nil(err)
+	suite.Equal("8.01", string(result))
 
-	result, err = FromString("13849502840392485906123.764538")
-	suite.Equal(ErrOverflow, err)
-	suite.Equal(Micro(0), result)
+	result, err = json.Marshal(FixedMicro(-8 * Cent))
+	suite.Nil(err)
+	suite.Equal("-0.08", string(result))
 
-	result, err = FromString("-9223372036854.775809")
-	suite.Equal(ErrOverflow, err)
-	suite.Equal(Micro(0), result)
+	result, err = json.Marshal(FixedMicro(1235000))
+	suite.Nil(err)
+	suite.Equal("1.24", string(result))
 
-	result, err = FromString("9223372036854.775808")
-	suite.Equal(ErrOverflow, err)
-	suite.Equal(Micro(0), result)
+	result, err = json.Marshal(struct{ Price FixedMicro }{FixedMicro(8 * Dollar)})
+	suite.Nil(err)
+	suite.Equal(`{"Price":8.00}`, string(result))
+
+	var decoded struct{ Price FixedMicro }
+	err = json.Unmarshal(result, &decoded)
+	suite.Nil(err)
+	suite.Equal(FixedMicro(8*Dollar), decoded.Price)
+
+	result, err = FixedMicro(MaxAmount + 1).MarshalJSON()
+	suite.Equal(ErrOverBounds, err)
+	suite.Nil(result)
 }
 
-func (suite *MoneyTestSuite) TestInvalidFromStringWithExp() {
-	result, err := FromString("123.764538e6")
-	suite.Equal(ErrInvalidInput, err)
-	suite.Equal(Micro(0), result)
+func (suite *MoneyTestSuite) TestMarshalText() {
+	m := Micro(0)
+	result, err := m.MarshalText()
+	suite.Nil(err)
+	suite.Equal("0", string(result))
 
-	result, err = FromString("123.764538E6")
-	suite.Equal(ErrInvalidInput, err)
-	suite.Equal(Micro(0), result)
+	m = 8 * Dollar
+	result, err = m.MarshalText()
+	suite.Nil(err)
+	suite.Equal("8", string(result))
 
-	result, err = FromString("123.764538e+6")
-	suite.Equal(ErrInvalidInput, err)
-	suite.Equal(Micro(0), result)
+	m = 801 * Cent
+	result, err = m.MarshalText()
+	suite.Nil(err)
+	suite.Equal("8.01", string(result))
 
-	result, err = FromString("3.7e10")
-	suite.Equal(ErrInvalidInput, err)
-	suite.Equal(Micro(0), result)
+	m = 8 * Cent
+	result, err = m.MarshalText()
+	suite.Nil(err)
+	suite.Equal("0.08", string(result))
 
-	result, err = FromString("-3.7e10")
-	suite.Equal(ErrInvalidInput, err)
-	suite.Equal(Micro(0), result)
+	m = -8 * Dollar
+	result, err = m.MarshalText()
+	suite.Nil(err)
+	suite.Equal("-8", string(result))
 
-	result, err = FromString("3.7134234545e10")
-	suite.Equal(ErrInvalidInput, err)
-	suite.Equal(Micro(0), result)
+	m = -801 * Cent
+	result, err = m.MarshalText()
+	suite.Nil(err)
+	suite.Equal("-8.01", string(result))
 }
 
-func (suite *MoneyTestSuite) TestValidToString() {
-	result := ToString(123764538)
-	suite.Equal("123.764538", result)
-
-	result = ToString(-999999)
-	suite.Equal("-0.999999", result)
+func (suite *MoneyTestSuite) TestUnmarshalText() {
+	m := Micro(100)
+	err := (&m).UnmarshalText(nil)
+	suite.Nil(err)
+	suite.Equal(Micro(100), m)
 
-	result = ToString(12352348976)
-	suite.Equal("12352.348976", result)
+	m = Micro(0)
+	err = (&m).UnmarshalText([]byte("0.08"))
+	suite.Nil(err)
+	suite.Equal(8*Cent, m)
 
-	result = ToString(123523489000)
-	suite.Equal("123523.489", result)
+	m = Micro(0)
+	err = (&m).UnmarshalText([]byte("8.01"))
+	suite.Nil(err)
+	suite.Equal(801*Cent, m)
 
-	result = ToString(123)
-	suite.Equal("0.000123", result)
+	m = Micro(0)
+	err = (&m).UnmarshalText([]byte("8"))
+	suite.Nil(err)
+	suite.Equal(8*Dollar, m)
 
-	result = ToString(0)
-	suite.Equal("0", result)
+	m = Micro(0)
+	err = (&m).UnmarshalText([]byte("-8.01"))
+	suite.Nil(err)
+	suite.Equal(-801*Cent, m)
+}
 
-	result = ToString(-123000000)
-	suite.Equal("-123", result)
+func (suite *MoneyTestSuite) TestInvalidUnmarshalText() {
+	m := Micro(0)
+	err := (&m).UnmarshalText([]byte("9223372036854.775808"))
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), m)
 
-	result = ToString(-123764538)
-	suite.Equal("-123.764538", result)
+	m = Micro(10)
+	err = (&m).UnmarshalText([]byte("8.01x"))
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(10), m)
 }
 
-func (suite *MoneyTestSuite) TestToFloat64() {
-	result, err := ToFloat64(123764538)
-	suite.Nil(err)
-	suite.Equal(123.764538, result)
+func (suite *MoneyTestSuite) TestJSONMapKeys() {
+	// map keys are encoded with MarshalText instead of as integers, so they read as amounts
+	prices := map[Micro]string{801 * Cent: "standard", -1: "refund", 0: "free", 123764538: "premium"}
 
-	result, err = ToFloat64(123)
+	result, err := json.Marshal(prices)
 	suite.Nil(err)
-	suite.Equal(0.000123, result)
+	suite.Equal(`{"-0.000001":"refund","0":"free","123.764538":"premium","8.01":"standard"}`, string(result))
 
-	result, err = ToFloat64(400)
+	var decoded map[Micro]string
+	err = json.Unmarshal(result, &decoded)
 	suite.Nil(err)
-	suite.Equal(0.0004, result)
+	suite.Equal(prices, decoded)
 
-	result, err = ToFloat64(0)
+	// keys that only differ in formatting decode to the same amount
+	err = json.Unmarshal([]byte(`{"8.010":"a"}`), &decoded)
 	suite.Nil(err)
-	suite.Equal(0.0, result)
+	suite.Equal("a", decoded[801*Cent])
 
-	result, err = ToFloat64(124311)
-	suite.Nil(err)
-	suite.Equal(0.124311, result)
+	err = json.Unmarshal([]byte(`{"8.01x":"a"}`), &decoded)
+	suite.True(errors.Is(err, ErrInvalidInput))
 
-	result, err = ToFloat64(1204311)
-	suite.Nil(err)
-	suite.Equal(1.204311, result)
+	_, err = json.Marshal(map[Micro]string{MaxAmount + 1: "too much"})
+	suite.True(errors.Is(err, ErrOverBounds))
+}
 
-	result, err = ToFloat64(1204311)
-	suite.Nil(err)
-	suite.Equal(1.204311, result)
+func (suite *MoneyTestSuite) TestXMLRoundTrip() {
+	type price struct {
+		Amount Micro `xml:"amount,attr"`
+		Tax    Micro `xml:"tax"`
+	}
 
-	result, err = ToFloat64(10204311)
+	result, err := xml.Marshal(price{Amount: 801 * Cent, Tax: -8 * Cent})
 	suite.Nil(err)
-	suite.Equal(10.204311, result)
+	suite.Equal(`<price amount="8.01"><tax>-0.08</tax></price>`, string(result))
 
-	result, err = ToFloat64(0)
+	var decoded price
+	err = xml.Unmarshal(result, &decoded)
 	suite.Nil(err)
-	suite.Equal(float64(0), result)
+	suite.Equal(price{Amount: 801 * Cent, Tax: -8 * Cent}, decoded)
+}
 
-	result, err = ToFloat64(9000000000000000)
-	suite.Nil(err)
-	suite.Equal(9000000000.000000, result)
+func (suite *MoneyTestSuite) TestCSV() {
+	for _, m := range []Micro{0, 801 * Cent, -801 * Cent, MaxAmount, MinAmount} {
+		result, err := m.CSV()
+		suite.Nil(err)
+		suite.Equal(ToString(m), result)
+
+		value, err := m.Value()
+		suite.Nil(err)
+		suite.Equal(value, result)
+	}
+
+	for _, m := range []Micro{MaxAmount + 1, MinAmount - 1, MaxMicro, MinMicro} {
+		result, err := m.CSV()
+		suite.Equal(ErrOverBounds, err)
+		suite.Equal("", result)
+
+		_, err = m.Value()
+		suite.Equal(ErrOverBounds, err)
+	}
 }
 
-func (suite *MoneyTestSuite) TestValidFromFloat64() {
-	result, err := FromFloat64(123.764538)
-	suite.Nil(err)
-	suite.Equal(Micro(123764538), result)
+type yamlConfig struct {
+	Price Micro `yaml:"price"`
+}
 
-	result, err = FromFloat64(123.52348976)
+func (suite *MoneyTestSuite) TestMarshalYAML() {
+	result, err := Micro(801 * Cent).MarshalYAML()
 	suite.Nil(err)
-	suite.Equal(Micro(123523489), result)
+	suite.Equal("8.01", result)
 
-	result, err = FromFloat64(123.523489)
+	result, err = Micro(-8 * Dollar).MarshalYAML()
 	suite.Nil(err)
-	suite.Equal(Micro(123523489), result)
+	suite.Equal("-8", result)
 
-	result, err = FromFloat64(123)
+	result, err = Micro(MaxAmount + 1).MarshalYAML()
+	suite.Equal(ErrOverBounds, err)
+	suite.Nil(result)
+
+	// yaml.v3 quotes the string, so that other decoders don't read the amount as a float
+	out, err := yaml.Marshal(yamlConfig{Price: 801 * Cent})
 	suite.Nil(err)
-	suite.Equal(Micro(123000000), result)
+	suite.Equal("price: \"8.01\"\n", string(out))
 
-	result, err = FromFloat64(12.3)
+	out, err = yaml.Marshal(yamlConfig{Price: -1})
 	suite.Nil(err)
-	suite.Equal(Micro(12300000), result)
+	suite.Equal("price: \"-0.000001\"\n", string(out))
+
+	_, err = yaml.Marshal(yamlConfig{Price: MaxAmount + 1})
+	suite.True(errors.Is(err, ErrOverBounds))
 }
 
-func (suite *MoneyTestSuite) TestInvalidFromFloat64() {
-	result, err := FromFloat64(13849502840392485906123.764538)
-	suite.Equal(ErrOverflow, err)
-	suite.Equal(Micro(0), result)
+func (suite *MoneyTestSuite) TestUnmarshalYAML() {
+	// quoted strings and bare numbers, including ones a float64 can't hold exactly
+	for input, expected := range map[string]Micro{
+		`price: "8.01"`:                      801 * Cent,
+		"price: 8.01":                        801 * Cent,
+		"price: -8":                          -8 * Dollar,
+		"price: '0.1'":                       100000,
+		"price: 8999999999.999999":           8999999999999999,
+		"price:   1.0000005  # rounded away": 1000001,
+	} {
+		var config yamlConfig
+		err := yaml.Unmarshal([]byte(input), &config)
+		suite.Nil(err, fmt.Sprintf("Input: %s", input))
+		suite.Equal(expected, config.Price, fmt.Sprintf("Input: %s", input))
+	}
+
+	config := yamlConfig{Price: 10}
+	err := yaml.Unmarshal([]byte("price: 8.01x"), &config)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(10), config.Price)
+
+	err = yaml.Unmarshal([]byte("price: [8.01]"), &config)
+	suite.NotNil(err)
+	suite.Equal(Micro(10), config.Price)
+
+	// round trip through the encoder
+	for _, m := range []Micro{0, 801 * Cent, -1, MaxAmount, MinAmount} {
+		out, err := yaml.Marshal(yamlConfig{Price: m})
+		suite.Nil(err)
+
+		var result yamlConfig
+		err = yaml.Unmarshal(out, &result)
+		suite.Nil(err)
+		suite.Equal(m, result.Price, fmt.Sprintf("Input: %d", m))
+	}
+}
+
+func (suite *MoneyTestSuite) TestProto() {
+	suite.Equal(int64(8010000), Micro(801*Cent).Proto())
+
+	for _, amount := range []Micro{0, 1, -1, 801 * Cent, MaxAmount, MinAmount} {
+		result, err := FromProto(amount.Proto())
+		suite.Nil(err)
+		suite.Equal(amount, result, fmt.Sprintf("Input: %d", amount))
+	}
+
+	result, err := FromProto(int64(MaxAmount) + 1)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromProto(math.MinInt64)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestMarshalBinary() {
+	result, err := Micro(0).MarshalBinary()
+	suite.Nil(err)
+	suite.Equal([]byte{0, 0, 0, 0, 0, 0, 0, 0}, result)
+
+	result, err = Micro(801 * Cent).MarshalBinary()
+	suite.Nil(err)
+	suite.Equal([]byte{0, 0, 0, 0, 0, 0x7a, 0x39, 0x10}, result)
+
+	result, err = Micro(-1).MarshalBinary()
+	suite.Nil(err)
+	suite.Equal([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, result)
+}
+
+func (suite *MoneyTestSuite) TestBinaryRoundTrip() {
+	for _, m := range []Micro{0, 801 * Cent, -801 * Cent, -1, MaxMicro, MinMicro} {
+		data, err := m.MarshalBinary()
+		suite.Nil(err)
+
+		var result Micro
+		err = (&result).UnmarshalBinary(data)
+		suite.Nil(err)
+		suite.Equal(m, result)
+	}
+}
+
+func (suite *MoneyTestSuite) TestInvalidUnmarshalBinary() {
+	m := Micro(10)
+	err := (&m).UnmarshalBinary(nil)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(10), m)
+
+	err = (&m).UnmarshalBinary([]byte{0, 0, 0, 0, 0, 0, 1})
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(10), m)
+
+	err = (&m).UnmarshalBinary([]byte{0, 0, 0, 0, 0, 0, 0, 0, 1})
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(10), m)
+}
+
+func (suite *MoneyTestSuite) TestGobRoundTrip() {
+	amounts := []Micro{0, 801 * Cent, -801 * Cent, MaxMicro, MinMicro}
+
+	buffer := bytes.Buffer{}
+	err := gob.NewEncoder(&buffer).Encode(amounts)
+	suite.Nil(err)
+
+	var result []Micro
+	err = gob.NewDecoder(&buffer).Decode(&result)
+	suite.Nil(err)
+	suite.Equal(amounts, result)
+}
+
+func (suite *MoneyTestSuite) TestGobEncode() {
+	result, err := Micro(-1).GobEncode()
+	suite.Nil(err)
+	suite.Equal([]byte{1, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, result)
+
+	for _, m := range []Micro{0, 801 * Cent, -801 * Cent, -1, MaxMicro, MinMicro} {
+		data, err := m.GobEncode()
+		suite.Nil(err)
+
+		var result Micro
+		err = (&result).GobDecode(data)
+		suite.Nil(err)
+		suite.Equal(m, result)
+	}
+
+	type account struct {
+		Balance Micro
+		Limits  []Micro
+	}
+	expected := account{Balance: -801 * Cent, Limits: []Micro{MinMicro, 0, MaxMicro}}
+
+	buffer := bytes.Buffer{}
+	err = gob.NewEncoder(&buffer).Encode(expected)
+	suite.Nil(err)
+
+	var decoded account
+	err = gob.NewDecoder(&buffer).Decode(&decoded)
+	suite.Nil(err)
+	suite.Equal(expected, decoded)
+}
+
+func (suite *MoneyTestSuite) TestInvalidGobDecode() {
+	m := Micro(10)
+	err := (&m).GobDecode(nil)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(10), m)
+
+	// unknown version
+	err = (&m).GobDecode([]byte{2, 0, 0, 0, 0, 0, 0, 0, 1})
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(10), m)
+
+	err = (&m).GobDecode([]byte{1, 0, 0, 0, 0, 0, 0, 1})
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(10), m)
+}
+
+func (suite *MoneyTestSuite) TestValidFromString() {
+	result, err := FromString("123.764538")
+	suite.Nil(err)
+	suite.Equal(Micro(123764538), result)
+
+	result, err = FromString("123.52348976")
+	suite.Nil(err)
+	suite.Equal(Micro(123523490), result)
+
+	result, err = FromString("0000123.523489")
+	suite.Nil(err)
+	suite.Equal(Micro(123523489), result)
+
+	result, err = FromString("123")
+	suite.Nil(err)
+	suite.Equal(Micro(123000000), result)
+
+	result, err = FromString("12.3")
+	suite.Nil(err)
+	suite.Equal(Micro(12300000), result)
+
+	result, err = FromString("-12")
+	suite.Nil(err)
+	suite.Equal(Micro(-12000000), result)
+
+	result, err = FromString("-12.3")
+	suite.Nil(err)
+	suite.Equal(Micro(-12300000), result)
+}
+
+func (suite *MoneyTestSuite) TestFromStrings() {
+	result, err := FromStrings([]string{"8.01", "-8", "0.000001"})
+	suite.Nil(err)
+	suite.Equal([]Micro{801 * Cent, -8 * Dollar, 1}, result)
+
+	result, err = FromStrings([]string{})
+	suite.Nil(err)
+	suite.Equal([]Micro{}, result)
+
+	result, err = FromStrings([]string{"8.01", "8.01x", "9223372036854.775808"})
+	suite.True(errors.Is(err, ErrInvalidInput))
+	suite.EqualError(err, "money: amount at index 1: money: cannot convert string to money.Micro")
+	suite.Nil(result)
+
+	result, err = FromStrings([]string{"8.01", "9223372036854.775808"})
+	suite.True(errors.Is(err, ErrOverflow))
+	suite.Nil(result)
+}
+
+// cancelAfterContext reports itself as cancelled once Err has been called more than checks times.
+type cancelAfterContext struct {
+	context.Context
+	checks int
+}
+
+func (ctx *cancelAfterContext) Err() error {
+	if ctx.checks == 0 {
+		return context.Canceled
+	}
+	ctx.checks--
+	return nil
+}
+
+func (suite *MoneyTestSuite) TestFromStringsContext() {
+	result, err := FromStringsContext(context.Background(), []string{"8.01", "-8", "0.000001"})
+	suite.Nil(err)
+	suite.Equal([]Micro{801 * Cent, -8 * Dollar, 1}, result)
+
+	result, err = FromStringsContext(context.Background(), []string{"8.01", "8.01x"})
+	suite.True(errors.Is(err, ErrInvalidInput))
+	suite.EqualError(err, "money: amount at index 1: money: cannot convert string to money.Micro")
+	suite.Nil(result)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err = FromStringsContext(ctx, []string{"8.01"})
+	suite.Equal(context.Canceled, err)
+	suite.Nil(result)
+
+	// cancelled after a few hundred amounts were already parsed
+	amounts := make([]string, 1000)
+	for i := range amounts {
+		amounts[i] = "8.01"
+	}
+	result, err = FromStringsContext(&cancelAfterContext{context.Background(), 3}, amounts)
+	suite.Equal(context.Canceled, err)
+	suite.Nil(result)
+}
+
+func (suite *MoneyTestSuite) TestMustFromString() {
+	suite.Equal(801*Cent, MustFromString("8.01"))
+	suite.Equal(-801*Cent, MustFromString("-8.01"))
+
+	suite.Panics(func() {
+		MustFromString("8.01x")
+	})
+
+	defer func() {
+		suite.Equal(`money: MustFromString("1.1.1"): money: cannot convert string to money.Micro`, recover())
+	}()
+	MustFromString("1.1.1")
+}
+
+func (suite *MoneyTestSuite) TestFromStringClamped() {
+	result, clamped, err := FromStringClamped("8.01")
+	suite.Nil(err)
+	suite.False(clamped)
+	suite.Equal(801*Cent, result)
+
+	result, clamped, err = FromStringClamped("9000000000")
+	suite.Nil(err)
+	suite.False(clamped)
+	suite.Equal(MaxAmount, result)
+
+	result, clamped, err = FromStringClamped("9000000000.000001")
+	suite.Nil(err)
+	suite.True(clamped)
+	suite.Equal(MaxAmount, result)
+
+	result, clamped, err = FromStringClamped("-9000000000.000001")
+	suite.Nil(err)
+	suite.True(clamped)
+	suite.Equal(MinAmount, result)
+
+	// amounts that don't even fit into Micro
+	result, clamped, err = FromStringClamped("+1" + strings.Repeat("0", 100) + ".5")
+	suite.Nil(err)
+	suite.True(clamped)
+	suite.Equal(MaxAmount, result)
+
+	result, clamped, err = FromStringClamped("-9223372036854.775809")
+	suite.Nil(err)
+	suite.True(clamped)
+	suite.Equal(MinAmount, result)
+
+	result, clamped, err = FromStringClamped("8.01x")
+	suite.Equal(ErrInvalidInput, err)
+	suite.False(clamped)
+	suite.Equal(Micro(0), result)
+
+	// the overflow happens before the invalid character is reached
+	result, clamped, err = FromStringClamped("1" + strings.Repeat("0", 100) + "x")
+	suite.Equal(ErrInvalidInput, err)
+	suite.False(clamped)
+	suite.Equal(Micro(0), result)
+
+	result, clamped, err = FromStringClamped("1" + strings.Repeat("0", 100) + ".5.")
+	suite.Equal(ErrInvalidInput, err)
+	suite.False(clamped)
+	suite.Equal(Micro(0), result)
+
+	result, clamped, err = FromStringClamped("")
+	suite.Equal(ErrEmptyInput, err)
+	suite.False(clamped)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestFromStringExact() {
+	result, rounded, err := FromStringExact("123.764538")
+	suite.Nil(err)
+	suite.False(rounded)
+	suite.Equal(Micro(123764538), result)
+
+	result, rounded, err = FromStringExact("123.7645380000")
+	suite.Nil(err)
+	suite.False(rounded)
+	suite.Equal(Micro(123764538), result)
+
+	result, rounded, err = FromStringExact("-123")
+	suite.Nil(err)
+	suite.False(rounded)
+	suite.Equal(Micro(-123000000), result)
+
+	result, rounded, err = FromStringExact("123.7645384")
+	suite.Nil(err)
+	suite.True(rounded)
+	suite.Equal(Micro(123764538), result)
+
+	result, rounded, err = FromStringExact("-123.7645385")
+	suite.Nil(err)
+	suite.True(rounded)
+	suite.Equal(Micro(-123764539), result)
+
+	result, rounded, err = FromStringExact("1.00000000000000011102230246251565404236316680908203125")
+	suite.Nil(err)
+	suite.True(rounded)
+	suite.Equal(Dollar, result)
+
+	result, rounded, err = FromStringExact("123.76453800001")
+	suite.Nil(err)
+	suite.True(rounded)
+	suite.Equal(Micro(123764538), result)
+
+	result, rounded, err = FromStringExact("123.7645384x")
+	suite.Equal(ErrInvalidInput, err)
+	suite.False(rounded)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestFromStringStrict() {
+	result, err := FromStringStrict("1.123456")
+	suite.Nil(err)
+	suite.Equal(Micro(1123456), result)
+
+	result, err = FromStringStrict("-1.1234560")
+	suite.Nil(err)
+	suite.Equal(Micro(-1123456), result)
+
+	result, err = FromStringStrict("1.1234567")
+	suite.Equal(ErrPrecisionLoss, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromStringStrict("-1.12345600000001")
+	suite.Equal(ErrPrecisionLoss, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromStringStrict("1.1.2")
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromStringStrict("9223372036854.775808")
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestAmountBounds() {
+	suite.Equal(9000000000*Dollar, MaxAmount)
+	suite.Equal(-9000000000*Dollar, MinAmount)
+
+	result, err := FromString("9000000000")
+	suite.Nil(err)
+	suite.Equal(MaxAmount, result)
+
+	result, err = FromString("-9000000000")
+	suite.Nil(err)
+	suite.Equal(MinAmount, result)
+
+	suite.Equal("9000000000", ToString(MaxAmount))
+	suite.Equal("-9000000000", ToString(MinAmount))
+}
+
+func (suite *MoneyTestSuite) TestFromStringWhitespace() {
+	for _, input := range []string{" 1.25 ", "\t1.25\n", "1.25\r\n", "\u00a01.25\u2003"} {
+		result, err := FromString(input)
+		suite.Nil(err, fmt.Sprintf("Input: %q", input))
+		suite.Equal(Micro(1250000), result, fmt.Sprintf("Input: %q", input))
+
+		result, err = FromBytes([]byte(input))
+		suite.Nil(err, fmt.Sprintf("Input: %q", input))
+		suite.Equal(Micro(1250000), result, fmt.Sprintf("Input: %q", input))
+
+		result, err = ParseDetailed(input)
+		suite.Nil(err, fmt.Sprintf("Input: %q", input))
+		suite.Equal(Micro(1250000), result, fmt.Sprintf("Input: %q", input))
+	}
+
+	// whitespace inside the number is still invalid
+	for _, input := range []string{"1 .25", "1. 25", "- 1.25", "123.7 64538"} {
+		result, err := FromString(input)
+		suite.Equal(ErrInvalidInput, err, fmt.Sprintf("Input: %q", input))
+		suite.Equal(Micro(0), result, fmt.Sprintf("Input: %q", input))
+	}
+
+	result, err := FromString(" \t\n")
+	suite.Equal(ErrEmptyInput, err)
+	suite.Equal(Micro(0), result)
+
+	result, precisionLost, err := FromStringExact(" 1.0000001\n")
+	suite.Nil(err)
+	suite.True(precisionLost)
+	suite.Equal(Micro(1000000), result)
+
+	result, err = FromStringStrict(" 1.25\n")
+	suite.Nil(err)
+	suite.Equal(Micro(1250000), result)
+
+	result, clamped, err := FromStringClamped(" 1" + strings.Repeat("0", 100) + "\n")
+	suite.Nil(err)
+	suite.True(clamped)
+	suite.Equal(MaxAmount, result)
+}
+
+func (suite *MoneyTestSuite) TestInvalidFromString() {
+	result, err := FromString("123.764.538")
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromString("123.7 64538")
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromString("a123.764538")
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromString("13849502840392485906123.764538")
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromString("-9223372036854.775809")
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromString("9223372036854.775808")
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestErrorsIs() {
+	_, err := FromString("x")
+	suite.True(errors.Is(err, ErrInvalidInput))
+
+	_, err = FromString("9223372036854.775808")
+	suite.True(errors.Is(err, ErrOverflow))
+
+	// blank input can be told apart from malformed input, but both are invalid
+	_, err = FromString("")
+	suite.True(errors.Is(err, ErrEmptyInput))
+	suite.True(errors.Is(err, ErrInvalidInput))
+	suite.True(errors.Is(ErrEmptyInput, ErrInvalidInput))
+	suite.EqualError(err, "money: cannot convert string to money.Micro: empty input")
+
+	_, err = FromString("1x")
+	suite.False(errors.Is(err, ErrEmptyInput))
+	suite.True(errors.Is(err, ErrInvalidInput))
+
+	_, err = FromStringLenient(" \t ")
+	suite.True(errors.Is(err, ErrEmptyInput))
+
+	_, err = FromStringLenient("$")
+	suite.False(errors.Is(err, ErrEmptyInput))
+	suite.True(errors.Is(err, ErrInvalidInput))
+
+	_, err = ParseDetailed("")
+	suite.Equal(ErrEmptyInput, err)
+
+	_, err = FromFloat64(13849502840392485906123.764538)
+	suite.True(errors.Is(err, ErrOverBounds))
+
+	_, err = Add(MaxMicro, 1)
+	suite.True(errors.Is(err, ErrOverflow))
+
+	_, err = Div(Dollar, 0, RoundingNone)
+	suite.True(errors.Is(err, ErrZeroDivision))
+
+	_, err = Div(Dollar, 3, 255)
+	suite.True(errors.Is(err, ErrUnsupportedRounding))
+
+	m := Micro(0)
+	err = (&m).Scan([]byte("x"))
+	suite.True(errors.Is(err, ErrInvalidInput))
+
+	err = (&m).Scan("9223372036854.775808")
+	suite.True(errors.Is(err, ErrOverflow))
+
+	_, err = Micro(MaxMicro).Value()
+	suite.True(errors.Is(err, ErrOverBounds))
+
+	err = json.Unmarshal([]byte(`{"Budget": "x"}`), &struct{ Budget Micro }{})
+	suite.True(errors.Is(err, ErrInvalidInput))
+
+	// errors can be wrapped with context and still matched
+	err = fmt.Errorf("parsing budget: %w", ErrOverflow)
+	suite.True(errors.Is(err, ErrOverflow))
+	suite.False(errors.Is(err, ErrInvalidInput))
+}
+
+func (suite *MoneyTestSuite) TestFromStringHuge() {
+	// the parser stops at the first overflowing digit, so it never sees the invalid trailing character
+	result, err := FromString("1" + strings.Repeat("0", 1000000) + "x")
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromString("-1" + strings.Repeat("0", 1000000) + ".5")
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), result)
+
+	// leading zeros and fractional digits don't overflow, so they are scanned and validated
+	result, err = FromString(strings.Repeat("0", 1000000) + "1.5")
+	suite.Nil(err)
+	suite.Equal(Micro(1500000), result)
+
+	result, err = FromString("0." + strings.Repeat("0", 1000000) + "x")
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestParseDetailed() {
+	result, err := ParseDetailed("-12.34")
+	suite.Nil(err)
+	suite.Equal(Micro(-12340000), result)
+
+	offsets := map[string]int{
+		"12.3x4":   4,
+		"x":        0,
+		"1.2.3":    3,
+		"--1":      1,
+		"1e10":     1,
+		" 12.3x4 ": 5,
+		"1 .25":    1,
+		"-":        1,
+		"+.":       2,
+	}
+	for input, offset := range offsets {
+		result, err := ParseDetailed(input)
+		suite.True(errors.Is(err, ErrInvalidInput), fmt.Sprintf("Input: %s", input))
+		var parseErr *ParseError
+		suite.True(errors.As(err, &parseErr), fmt.Sprintf("Input: %s", input))
+		suite.Equal(&ParseError{Input: input, Offset: offset}, parseErr, fmt.Sprintf("Input: %s", input))
+		suite.Equal(Micro(0), result, fmt.Sprintf("Input: %s", input))
+	}
+
+	_, err = ParseDetailed("12.3x4")
+	suite.EqualError(err, `money: cannot convert "12.3x4" to money.Micro: invalid character at offset 4`)
+
+	result, err = ParseDetailed("9223372036854.775808")
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestInvalidFromStringWithExp() {
+	result, err := FromString("123.764538e6")
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromString("123.764538E6")
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromString("123.764538e+6")
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromString("3.7e10")
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromString("-3.7e10")
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromString("3.7134234545e10")
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+
+	// an explicit plus sign doesn't change that
+	result, err = FromString("+1.5e3")
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromBytes([]byte("+1.5e3"))
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestExponentsSharedPath() {
+	// every string entry point rejects exponents in the same place, whatever the size of the exponent
+	offsets := map[string]int{
+		"123.764538e6":           10,
+		"0.1e10":                 3,
+		"1e-9223372036854775808": 1,
+		"1e9223372036854775807":  1,
+		".5E1":                   2,
+		"+1.5e3":                 4,
+	}
+	for input, offset := range offsets {
+		result, err := FromString(input)
+		suite.True(errors.Is(err, ErrInvalidInput), fmt.Sprintf("Input: %s", input))
+		suite.Equal(Micro(0), result, fmt.Sprintf("Input: %s", input))
+
+		result, err = FromBytes([]byte(input))
+		suite.True(errors.Is(err, ErrInvalidInput), fmt.Sprintf("Input: %s", input))
+		suite.Equal(Micro(0), result, fmt.Sprintf("Input: %s", input))
+
+		result, err = ParseDetailed(input)
+		suite.Equal(&ParseError{Input: input, Offset: offset}, err, fmt.Sprintf("Input: %s", input))
+		suite.Equal(Micro(0), result, fmt.Sprintf("Input: %s", input))
+	}
+	// a mantissa that overflows is reported before the exponent is reached, as no exponent could scale it back
+	for _, input := range []string{"9223372036854775808123e-20", "-9223372036854775808123e-20"} {
+		result, err := FromString(input)
+		suite.Equal(ErrOverflow, err, fmt.Sprintf("Input: %s", input))
+		suite.Equal(Micro(0), result, fmt.Sprintf("Input: %s", input))
+
+		result, err = ParseDetailed(input)
+		suite.Equal(ErrOverflow, err, fmt.Sprintf("Input: %s", input))
+		suite.Equal(Micro(0), result, fmt.Sprintf("Input: %s", input))
+	}
+}
+
+func (suite *MoneyTestSuite) TestValidToString() {
+	result := ToString(123764538)
+	suite.Equal("123.764538", result)
+
+	result = ToString(-999999)
+	suite.Equal("-0.999999", result)
+
+	result = ToString(12352348976)
+	suite.Equal("12352.348976", result)
+
+	result = ToString(123523489000)
+	suite.Equal("123523.489", result)
+
+	result = ToString(123)
+	suite.Equal("0.000123", result)
+
+	result = ToString(0)
+	suite.Equal("0", result)
+
+	result = ToString(-123000000)
+	suite.Equal("-123", result)
+
+	result = ToString(-123764538)
+	suite.Equal("-123.764538", result)
+
+	// amounts above 9000000000 dollars are formatted as well
+	result = ToString(9000000000000001)
+	suite.Equal("9000000000.000001", result)
+
+	result = ToString(MaxMicro)
+	suite.Equal("9223372036854.775807", result)
+
+	result = ToString(MinMicro)
+	suite.Equal("-9223372036854.775808", result)
+}
+
+func (suite *MoneyTestSuite) TestToStringSigned() {
+	suite.Equal("+5", ToStringSigned(5*Dollar))
+	suite.Equal("+8.01", ToStringSigned(801*Cent))
+	suite.Equal("+0.000001", ToStringSigned(1))
+	suite.Equal("-3", ToStringSigned(-3*Dollar))
+	suite.Equal("-0.5", ToStringSigned(-50*Cent))
+	suite.Equal("0", ToStringSigned(0))
+	suite.Equal("+9223372036854.775807", ToStringSigned(MaxMicro))
+	suite.Equal("-9223372036854.775808", ToStringSigned(MinMicro))
+
+	// the output parses back
+	for _, amount := range []Micro{5 * Dollar, -50 * Cent, 0, 1} {
+		parsed, err := FromString(ToStringSigned(amount))
+		suite.Nil(err)
+		suite.Equal(amount, parsed)
+	}
+}
+
+func (suite *MoneyTestSuite) TestToStringTinyNegatives() {
+	// the integer part of these is 0, so the sign has to come from the fraction
+	expected := map[Micro]string{
+		-1:      "-0.000001",
+		-2:      "-0.000002",
+		-10:     "-0.00001",
+		-100000: "-0.1",
+		-500000: "-0.5",
+		-500001: "-0.500001",
+		-999999: "-0.999999",
+	}
+	for amount, str := range expected {
+		suite.Equal(str, ToString(amount), fmt.Sprintf("Input: %d", amount))
+		suite.Equal(str, string(amount.AppendString(nil)), fmt.Sprintf("Input: %d", amount))
+
+		fixed, err := ToStringFixed(amount, 6)
+		suite.Nil(err)
+		suite.Equal(str+strings.Repeat("0", 9-len(str)), fixed, fmt.Sprintf("Input: %d", amount))
+
+		parsed, err := FromString(str)
+		suite.Nil(err)
+		suite.Equal(amount, parsed, fmt.Sprintf("Input: %d", amount))
+	}
+
+	// one whole dollar is on the other side of the special case
+	suite.Equal("-1", ToString(-1000000))
+	suite.Equal("-1.000001", ToString(-1000001))
+}
+
+func (suite *MoneyTestSuite) TestAppendString() {
+	result := Micro(801 * Cent).AppendString([]byte("amount: "))
+	suite.Equal("amount: 8.01", string(result))
+
+	result = Micro(-999999).AppendString(nil)
+	suite.Equal("-0.999999", string(result))
+
+	amounts := []Micro{0, 1, -1, 10, -10, 100000, -100000, 999999, -999999, MaxMicro, MinMicro}
+	for i := Micro(-2000000); i <= 2000000; i += 3333 {
+		amounts = append(amounts, i, i*1000003)
+	}
+
+	buffer := []byte("x")
+	for _, amount := range amounts {
+		expected := ToString(amount)
+		suite.Equal("x"+expected, string(amount.AppendString(buffer)), fmt.Sprintf("Input: %d", amount))
+
+		parsed, err := FromString(expected)
+		suite.Nil(err)
+		suite.Equal(amount, parsed, fmt.Sprintf("Input: %d", amount))
+	}
+}
+
+func (suite *MoneyTestSuite) TestToScientificString() {
+	result, err := ToScientificString(123456 * Cent)
+	suite.Nil(err)
+	suite.Equal("1.23456e3", result)
+
+	result, err = ToScientificString(-123456 * Cent)
+	suite.Nil(err)
+	suite.Equal("-1.23456e3", result)
+
+	result, err = ToScientificString(MaxAmount)
+	suite.Nil(err)
+	suite.Equal("9e9", result)
+
+	result, err = ToScientificString(5 * Dollar)
+	suite.Nil(err)
+	suite.Equal("5e0", result)
+
+	result, err = ToScientificString(50 * Cent)
+	suite.Nil(err)
+	suite.Equal("5e-1", result)
+
+	result, err = ToScientificString(123764)
+	suite.Nil(err)
+	suite.Equal("1.23764e-1", result)
+
+	result, err = ToScientificString(-1)
+	suite.Nil(err)
+	suite.Equal("-1e-6", result)
+
+	result, err = ToScientificString(0)
+	suite.Nil(err)
+	suite.Equal("0e0", result)
+
+	result, err = ToScientificString(MaxAmount + 1)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal("", result)
+
+	result, err = ToScientificString(MinMicro)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal("", result)
+}
+
+func (suite *MoneyTestSuite) TestGoString() {
+	suite.Equal("money.Micro(8010000 /* 8.01 */)", Micro(801*Cent).GoString())
+	suite.Equal("money.Micro(-8010000 /* -8.01 */)", fmt.Sprintf("%#v", -801*Cent))
+	suite.Equal("money.Micro(0 /* 0 */)", fmt.Sprintf("%#v", Micro(0)))
+	suite.Equal("struct { Price money.Micro }{Price:money.Micro(-1 /* -0.000001 */)}", fmt.Sprintf("%#v", struct{ Price Micro }{-1}))
+}
+
+func (suite *MoneyTestSuite) TestToStringFixed() {
+	for _, test := range toStringFixedTests {
+		result, err := ToStringFixed(test.input, test.decimals)
+		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %d, %d", test.input, test.decimals))
+		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %d, %d", test.input, test.decimals))
+	}
+}
+
+func (suite *MoneyTestSuite) TestToFloatStringFixed() {
+	result, err := ToFloatStringFixed(123523489000, 6)
+	suite.Nil(err)
+	suite.Equal("123523.489000", result)
+
+	result, err = ToFloatStringFixed(5*Dollar, 6)
+	suite.Nil(err)
+	suite.Equal("5.000000", result)
+
+	result, err = ToFloatStringFixed(-1, 6)
+	suite.Nil(err)
+	suite.Equal("-0.000001", result)
+
+	result, err = ToFloatStringFixed(123523489000, 2)
+	suite.Nil(err)
+	suite.Equal("123523.49", result)
+
+	result, err = ToFloatStringFixed(-123764538, 2)
+	suite.Nil(err)
+	suite.Equal("-123.76", result)
+
+	result, err = ToFloatStringFixed(MaxAmount, 2)
+	suite.Nil(err)
+	suite.Equal("9000000000.00", result)
+
+	result, err = ToFloatStringFixed(MaxAmount+1, 2)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal("", result)
+
+	result, err = ToFloatStringFixed(MinAmount-1, 6)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal("", result)
+
+	result, err = ToFloatStringFixed(5*Dollar, -1)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal("", result)
+}
+
+func (suite *MoneyTestSuite) TestFormat() {
+	for _, test := range formatTests {
+		result, err := Format(test.input, test.decimals, test.thousandsSep, test.decimalSep)
+		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %d, %d", test.input, test.decimals))
+		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %d, %d", test.input, test.decimals))
+	}
+}
+
+func (suite *MoneyTestSuite) TestFormatCurrency() {
+	result, err := FormatCurrency(123456*Cent, "$", true, 2)
+	suite.Nil(err)
+	suite.Equal("$1234.56", result)
+
+	result, err = FormatCurrency(123456*Cent, "€", false, 2)
+	suite.Nil(err)
+	suite.Equal("1234.56 €", result)
+
+	result, err = FormatCurrency(-5*Dollar, "$", true, 2)
+	suite.Nil(err)
+	suite.Equal("-$5.00", result)
+
+	result, err = FormatCurrency(-5*Dollar, "€", false, 2)
+	suite.Nil(err)
+	suite.Equal("-5.00 €", result)
+
+	result, err = FormatCurrency(1000*Dollar, "¥", true, 0)
+	suite.Nil(err)
+	suite.Equal("¥1000", result)
+
+	result, err = FormatCurrency(5*Dollar, "$", true, -1)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal("", result)
+}
+
+func (suite *MoneyTestSuite) TestFormatAccounting() {
+	result, err := FormatAccounting(5*Dollar, 2)
+	suite.Nil(err)
+	suite.Equal("5.00", result)
+
+	result, err = FormatAccounting(0, 2)
+	suite.Nil(err)
+	suite.Equal("0.00", result)
+
+	result, err = FormatAccounting(-5*Dollar, 2)
+	suite.Nil(err)
+	suite.Equal("(5.00)", result)
+
+	result, err = FormatAccounting(-123456789, 3)
+	suite.Nil(err)
+	suite.Equal("(123.457)", result)
+
+	// rounds to zero so there is nothing negative to show
+	result, err = FormatAccounting(-4999, 2)
+	suite.Nil(err)
+	suite.Equal("0.00", result)
+
+	result, err = FormatAccounting(-5*Dollar, -1)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal("", result)
+}
+
+func (suite *MoneyTestSuite) TestFromMicros() {
+	result, err := FromMicros(8010000)
+	suite.Nil(err)
+	suite.Equal(801*Cent, result)
+
+	result, err = FromMicros(int64(MaxAmount))
+	suite.Nil(err)
+	suite.Equal(MaxAmount, result)
+
+	result, err = FromMicros(int64(MinAmount))
+	suite.Nil(err)
+	suite.Equal(MinAmount, result)
+
+	result, err = FromMicros(int64(MaxAmount) + 1)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromMicros(int64(MinAmount) - 1)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromMicros(math.MinInt64)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestCents() {
+	result, err := FromCents(12345)
+	suite.Nil(err)
+	suite.Equal(Micro(123450000), result)
+
+	cents, err := ToCents(result, RoundingNone)
+	suite.Nil(err)
+	suite.Equal(int64(12345), cents)
+
+	result, err = FromCents(-12345)
+	suite.Nil(err)
+	suite.Equal(Micro(-123450000), result)
+
+	result, err = FromCents(math.MaxInt64 / 1000)
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), result)
+
+	cents, err = ToCents(Micro(1234567), RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(int64(123), cents)
+
+	cents, err = ToCents(Micro(1235000), RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(int64(124), cents)
+
+	cents, err = ToCents(Micro(1235000), RoundingNone)
+	suite.Nil(err)
+	suite.Equal(int64(123), cents)
+
+	cents, err = ToCents(Micro(-1235000), RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(int64(-124), cents)
+
+	cents, err = ToCents(Micro(1230001), RoundingCeil)
+	suite.Nil(err)
+	suite.Equal(int64(124), cents)
+
+	cents, err = ToCents(Micro(1235000), 255)
+	suite.Equal(ErrUnsupportedRounding, err)
+	suite.Equal(int64(0), cents)
+}
+
+func (suite *MoneyTestSuite) TestToCentsString() {
+	result, err := ToCentsString(1099*Cent, RoundingNone)
+	suite.Nil(err)
+	suite.Equal("1099", result)
+
+	result, err = ToCentsString(5*Dollar, RoundingNone)
+	suite.Nil(err)
+	suite.Equal("500", result)
+
+	result, err = ToCentsString(-1099*Cent, RoundingNone)
+	suite.Nil(err)
+	suite.Equal("-1099", result)
+
+	result, err = ToCentsString(0, RoundingNone)
+	suite.Nil(err)
+	suite.Equal("0", result)
+
+	// 10.995 has to be rounded
+	result, err = ToCentsString(10995000, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal("1100", result)
+
+	result, err = ToCentsString(10995000, RoundingFloor)
+	suite.Nil(err)
+	suite.Equal("1099", result)
+
+	result, err = ToCentsString(-10995000, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal("-1100", result)
+
+	result, err = ToCentsString(10995000, 255)
+	suite.Equal(ErrUnsupportedRounding, err)
+	suite.Equal("", result)
+}
+
+func (suite *MoneyTestSuite) TestInt64Dollar() {
+	result, err := FromInt64Dollar(123)
+	suite.Nil(err)
+	suite.Equal(123*Dollar, result)
+
+	result, err = FromInt64Dollar(-123)
+	suite.Nil(err)
+	suite.Equal(-123*Dollar, result)
+
+	result, err = FromInt64Dollar(9223372036854)
+	suite.Nil(err)
+	suite.Equal(Micro(9223372036854000000), result)
+
+	result, err = FromInt64Dollar(9223372036855)
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromInt64Dollar(-9223372036855)
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), result)
+
+	dollars, err := ToInt64Dollar(123*Dollar, RoundingNone)
+	suite.Nil(err)
+	suite.Equal(int64(123), dollars)
+
+	dollars, err = ToInt64Dollar(Micro(2500000), RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(int64(3), dollars)
+
+	dollars, err = ToInt64Dollar(Micro(2499999), RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(int64(2), dollars)
+
+	dollars, err = ToInt64Dollar(Micro(-2500000), RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(int64(-3), dollars)
+
+	dollars, err = ToInt64Dollar(Micro(-2500000), RoundingFloor)
+	suite.Nil(err)
+	suite.Equal(int64(-3), dollars)
+
+	dollars, err = ToInt64Dollar(Micro(2500000), 255)
+	suite.Equal(ErrUnsupportedRounding, err)
+	suite.Equal(int64(0), dollars)
+}
+
+func (suite *MoneyTestSuite) TestToFloat64() {
+	result, err := ToFloat64(123764538)
+	suite.Nil(err)
+	suite.Equal(123.764538, result)
+
+	result, err = ToFloat64(123)
+	suite.Nil(err)
+	suite.Equal(0.000123, result)
+
+	result, err = ToFloat64(400)
+	suite.Nil(err)
+	suite.Equal(0.0004, result)
+
+	result, err = ToFloat64(0)
+	suite.Nil(err)
+	suite.Equal(0.0, result)
+
+	result, err = ToFloat64(124311)
+	suite.Nil(err)
+	suite.Equal(0.124311, result)
+
+	result, err = ToFloat64(1204311)
+	suite.Nil(err)
+	suite.Equal(1.204311, result)
+
+	result, err = ToFloat64(1204311)
+	suite.Nil(err)
+	suite.Equal(1.204311, result)
+
+	result, err = ToFloat64(10204311)
+	suite.Nil(err)
+	suite.Equal(10.204311, result)
+
+	result, err = ToFloat64(0)
+	suite.Nil(err)
+	suite.Equal(float64(0), result)
+
+	result, err = ToFloat64(9000000000000000)
+	suite.Nil(err)
+	suite.Equal(9000000000.000000, result)
+
+	result, err = ToFloat64(MinAmount)
+	suite.Nil(err)
+	suite.Equal(-9000000000.000000, result)
+
+	result, err = ToFloat64(MaxAmount + 1)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(0.0, result)
+
+	result, err = ToFloat64(MinAmount - 1)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(0.0, result)
+
+	result, err = ToFloat64(MaxMicro)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(0.0, result)
+}
+
+func (suite *MoneyTestSuite) TestValidFromFloat64() {
+	result, err := FromFloat64(123.764538)
+	suite.Nil(err)
+	suite.Equal(Micro(123764538), result)
+
+	result, err = FromFloat64(123.52348976)
+	suite.Nil(err)
+	suite.Equal(Micro(123523490), result)
+
+	result, err = FromFloat64(123.523489)
+	suite.Nil(err)
+	suite.Equal(Micro(123523489), result)
+
+	result, err = FromFloat64(123)
+	suite.Nil(err)
+	suite.Equal(Micro(123000000), result)
+
+	result, err = FromFloat64(12.3)
+	suite.Nil(err)
+	suite.Equal(Micro(12300000), result)
+}
+
+func (suite *MoneyTestSuite) TestFromFloat64RoundsHalfAwayFromZero() {
+	result, err := FromFloat64(0.00000051)
+	suite.Nil(err)
+	suite.Equal(Micro(1), result)
+
+	result, err = FromFloat64(0.00000049)
+	suite.Nil(err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromFloat64(-0.00000051)
+	suite.Nil(err)
+	suite.Equal(Micro(-1), result)
+
+	result, err = FromFloat64(-0.00000049)
+	suite.Nil(err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromFloat64(1.2345675001)
+	suite.Nil(err)
+	suite.Equal(Micro(1234568), result)
+
+	result, err = FromFloat64(1.2345674999)
+	suite.Nil(err)
+	suite.Equal(Micro(1234567), result)
+
+	result, err = FromFloat64(-1.2345675001)
+	suite.Nil(err)
+	suite.Equal(Micro(-1234568), result)
+
+	result, err = FromFloat64(-1.2345674999)
+	suite.Nil(err)
+	suite.Equal(Micro(-1234567), result)
+}
+
+func (suite *MoneyTestSuite) TestInvalidFromFloat64() {
+	result, err := FromFloat64(13849502840392485906123.764538)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromFloat64(-9223372036854.776808)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromFloat64(9223372036854.776807)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(Micro(0), result)
+
+	// 9e9 dollars is exact in float64, so the bounds are inclusive and reliable
+	result, err = FromFloat64(9000000000)
+	suite.Nil(err)
+	suite.Equal(MaxAmount, result)
+
+	result, err = FromFloat64(-9000000000)
+	suite.Nil(err)
+	suite.Equal(MinAmount, result)
+
+	result, err = FromFloat64(8999999999.999998)
+	suite.Nil(err)
+	suite.Equal(MaxAmount-2, result)
+
+	result, err = FromFloat64(9000000000.000002)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromFloat64(-9000000000.000002)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromFloat64(9000000001)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(Micro(0), result)
+	result, err = FromFloat64(math.NaN())
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromFloat64(math.Inf(1))
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromFloat64(math.Inf(-1))
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromFloat64Rounding(math.NaN(), RoundingFloor)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestFromFloat64ShortestDecimal() {
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		// a few decimal places beyond micros, so that many of them are ties
+		amount := float64(random.Int63n(2e12)-1e12) / math.Pow10(random.Intn(4)+5)
+		expected, expectedErr := FromString(strconv.FormatFloat(amount, 'f', -1, 64))
+
+		result, err := FromFloat64(amount)
+		suite.Equal(expectedErr, err, fmt.Sprintf("Input: %v", amount))
+		suite.Equal(expected, result, fmt.Sprintf("Input: %v", amount))
+	}
+}
+
+func (suite *MoneyTestSuite) TestParseFloatString() {
+	for _, test := range parseFloatStringTests {
+		result, err := parseFloatString(test.input)
+		suite.Equal(test.err, err, fmt.Sprintf("Input: %s", test.input))
+		suite.Equal(test.expected, result, fmt.Sprintf("Input: %s", test.input))
+	}
+}
+
+func (suite *MoneyTestSuite) TestFromBytes() {
+	for _, test := range parseFloatStringTests {
+		result, err := FromBytes([]byte(test.input))
+		suite.Equal(test.err, err, fmt.Sprintf("Input: %s", test.input))
+		suite.Equal(test.expected, result, fmt.Sprintf("Input: %s", test.input))
+	}
+
+	result, err := FromBytes(nil)
+	suite.Equal(ErrEmptyInput, err)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestFromStringGrouped() {
+	for _, test := range fromStringGroupedTests {
+		result, err := FromStringGrouped(test.input)
+		suite.Equal(test.err, err, fmt.Sprintf("Input: %s", test.input))
+		suite.Equal(test.expected, result, fmt.Sprintf("Input: %s", test.input))
+	}
+
+	// the default parser still rejects underscores
+	result, err := FromString("1_000")
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestFromStringLocale() {
+	for _, test := range fromStringLocaleTests {
+		result, err := FromStringLocale(test.input, test.thousandsSep, test.decimalSep)
+		suite.Equal(test.err, err, fmt.Sprintf("Input: %s", test.input))
+		suite.Equal(test.expected, result, fmt.Sprintf("Input: %s", test.input))
+	}
+}
+
+func (suite *MoneyTestSuite) TestFromStringLenient() {
+	for _, test := range fromStringLenientTests {
+		result, err := FromStringLenient(test.input)
+		suite.Equal(test.err, err, fmt.Sprintf("Input: %s", test.input))
+		suite.Equal(test.expected, result, fmt.Sprintf("Input: %s", test.input))
+	}
+}
+
+func (suite *MoneyTestSuite) TestAdd() {
+	for _, test := range addTests {
+		result, err := Add(test.input1, test.input2)
+		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %d, %d", test.input1, test.input2))
+		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %d, %d", test.input1, test.input2))
+	}
+}
+
+func (suite *MoneyTestSuite) TestSub() {
+	for _, test := range subTests {
+		result, err := Sub(test.input1, test.input2)
+		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %d, %d", test.input1, test.input2))
+		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %d, %d", test.input1, test.input2))
+	}
+}
+
+func (suite *MoneyTestSuite) TestMul() {
+	for _, test := range mulTests {
+		result, err := Mul(test.input1, test.input2)
+		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %d, %d", test.input1, test.input2))
+		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %d, %d", test.input1, test.input2))
+	}
+}
+
+func (suite *MoneyTestSuite) TestDiv() {
+	for _, test := range divTests {
+		result, err := Div(test.input1, test.input2, test.rounding)
+		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %d, %d", test.input1, test.input2))
+		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %d, %d", test.input1, test.input2))
+	}
+}
+
+func (suite *MoneyTestSuite) TestRoundingName() {
+	suite.Equal("none", RoundingName(RoundingNone))
+	suite.Equal("half-away-from-zero", RoundingName(RoundingHalfAwayFromZero))
+	suite.Equal("ceil", RoundingName(RoundingCeil))
+	suite.Equal("floor", RoundingName(RoundingFloor))
+	suite.Equal("toward-zero", RoundingName(RoundingTowardZero))
+	suite.Equal("half-up", RoundingName(RoundingHalfUp))
+	suite.Equal("half-down", RoundingName(RoundingHalfDown))
+	suite.Equal("unknown", RoundingName(7))
+	suite.Equal("unknown", RoundingName(255))
+}
+
+func (suite *MoneyTestSuite) TestIsValidRounding() {
+	for _, rounding := range []byte{RoundingNone, RoundingHalfAwayFromZero, RoundingCeil, RoundingFloor, RoundingTowardZero, RoundingHalfUp, RoundingHalfDown} {
+		suite.True(IsValidRounding(rounding), fmt.Sprintf("Input: %d", rounding))
+	}
+	suite.False(IsValidRounding(7))
+	suite.False(IsValidRounding(255))
+
+	// agrees with what Div accepts
+	for rounding := 0; rounding < 256; rounding++ {
+		_, err := Div(7, 2, byte(rounding))
+		suite.Equal(IsValidRounding(byte(rounding)), err != ErrUnsupportedRounding, fmt.Sprintf("Input: %d", rounding))
+	}
+
+	// zero division is still reported first
+	_, err := Div(7, 0, 255)
+	suite.Equal(ErrZeroDivision, err)
+}
+
+func (suite *MoneyTestSuite) TestDivHalfTies() {
+	for _, test := range halfTieDivTests {
+		result, err := Div(test.input1, test.input2, test.rounding)
+		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %d, %d, %d", test.input1, test.input2, test.rounding))
+		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %d, %d, %d", test.input1, test.input2, test.rounding))
+
+		// the big.Int path has to agree
+		result, err = MulDiv(test.input1, 1, test.input2, test.rounding)
+		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %d, %d, %d", test.input1, test.input2, test.rounding))
+		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %d, %d, %d", test.input1, test.input2, test.rounding))
+	}
+}
+
+func (suite *MoneyTestSuite) TestFromFloat64WithRounding() {
+	for _, test := range fromFloat64RoundingTests {
+		result, err := FromFloat64Rounding(test.input, test.rounding)
+		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %v, %d", test.input, test.rounding))
+		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %v, %d", test.input, test.rounding))
+	}
+}
+
+func (suite *MoneyTestSuite) TestMulRat() {
+	for _, test := range mulRatTests {
+		result, err := MulRat(test.amount, test.num, test.den)
+		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %d, %d, %d", test.amount, test.num, test.den))
+		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %d, %d, %d", test.amount, test.num, test.den))
+	}
+}
+
+func (suite *MoneyTestSuite) TestMulDiv() {
+	for _, test := range mulDivTests {
+		result, err := MulDiv(test.amount, test.mul, test.div, test.rounding)
+		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %d, %d, %d, %d", test.amount, test.mul, test.div, test.rounding))
+		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %d, %d, %d, %d", test.amount, test.mul, test.div, test.rounding))
+	}
+}
+
+func (suite *MoneyTestSuite) TestDefaultRounding() {
+	defer SetDefaultRounding(DefaultRounding())
+
+	suite.Equal(byte(RoundingHalfAwayFromZero), DefaultRounding())
+	result, err := DivDefault(-5, 2)
+	suite.Nil(err)
+	suite.Equal(Micro(-3), result)
+
+	suite.Nil(SetDefaultRounding(RoundingHalfUp))
+	suite.Equal(byte(RoundingHalfUp), DefaultRounding())
+	result, err = DivDefault(-5, 2)
+	suite.Nil(err)
+	suite.Equal(Micro(-2), result)
+
+	suite.Nil(SetDefaultRounding(RoundingFloor))
+	result, err = DivDefault(5, 2)
+	suite.Nil(err)
+	suite.Equal(Micro(2), result)
+
+	// an invalid mode leaves the default unchanged
+	suite.Equal(ErrUnsupportedRounding, SetDefaultRounding(255))
+	suite.Equal(byte(RoundingFloor), DefaultRounding())
+
+	result, err = DivDefault(5, 0)
+	suite.Equal(ErrZeroDivision, err)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestDivMod() {
+	quotient, remainder, err := DivMod(10*Dollar, 3)
+	suite.Nil(err)
+	suite.Equal(Micro(3333333), quotient)
+	suite.Equal(Micro(1), remainder)
+
+	amounts := []Micro{0, 1, -1, 10 * Dollar, -10 * Dollar, 1234567, -1234567, MaxMicro, MinMicro}
+	divisors := []int64{1, -1, 3, -3, 7, -7, 1000000, -1000000, math.MaxInt64, math.MinInt64}
+	for _, amount := range amounts {
+		for _, divisor := range divisors {
+			if amount == MinMicro && divisor == -1 {
+				continue
+			}
+
+			quotient, remainder, err := DivMod(amount, divisor)
+			suite.Nil(err, fmt.Sprintf("Inputs: %d, %d", amount, divisor))
+			suite.Equal(amount, quotient*Micro(divisor)+remainder, fmt.Sprintf("Inputs: %d, %d", amount, divisor))
+			suite.True(remainder == 0 || (remainder < 0) == (amount < 0), fmt.Sprintf("Inputs: %d, %d", amount, divisor))
+
+			truncated, err := Div(amount, divisor, RoundingTowardZero)
+			suite.Nil(err)
+			suite.Equal(truncated, quotient, fmt.Sprintf("Inputs: %d, %d", amount, divisor))
+		}
+	}
+
+	quotient, remainder, err = DivMod(MinMicro, -1)
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), quotient)
+	suite.Equal(Micro(0), remainder)
+
+	quotient, remainder, err = DivMod(10*Dollar, 0)
+	suite.Equal(ErrZeroDivision, err)
+	suite.Equal(Micro(0), quotient)
+	suite.Equal(Micro(0), remainder)
+}
+
+func (suite *MoneyTestSuite) TestNegate() {
+	m := 801 * Cent
+	suite.Nil(m.Negate())
+	suite.Equal(-801*Cent, m)
+	suite.Nil(m.Negate())
+	suite.Equal(801*Cent, m)
+
+	m = 0
+	suite.Nil(m.Negate())
+	suite.Equal(Micro(0), m)
+
+	m = MaxMicro
+	suite.Nil(m.Negate())
+	suite.Equal(Micro(-MaxMicro), m)
+
+	m = MinMicro
+	suite.Equal(ErrOverflow, m.Negate())
+	suite.Equal(Micro(MinMicro), m)
+}
+
+func (suite *MoneyTestSuite) TestMakeAbs() {
+	m := -801 * Cent
+	suite.Nil(m.MakeAbs())
+	suite.Equal(801*Cent, m)
+	suite.Nil(m.MakeAbs())
+	suite.Equal(801*Cent, m)
+
+	m = -1
+	suite.Nil(m.MakeAbs())
+	suite.Equal(Micro(1), m)
+
+	m = MinMicro + 1
+	suite.Nil(m.MakeAbs())
+	suite.Equal(Micro(MaxMicro), m)
+
+	m = MinMicro
+	suite.Equal(ErrOverflow, m.MakeAbs())
+	suite.Equal(Micro(MinMicro), m)
 
-	result, err = FromFloat64(-9223372036854.776808)
+	// fields can be updated in place
+	line := struct{ Amount Micro }{-5 * Dollar}
+	suite.Nil(line.Amount.MakeAbs())
+	suite.Equal(5*Dollar, line.Amount)
+}
+
+func (suite *MoneyTestSuite) TestDollarsAndMicros() {
+	dollars, micros := Micro(123764538).DollarsAndMicros()
+	suite.Equal(int64(123), dollars)
+	suite.Equal(int64(764538), micros)
+
+	dollars, micros = Micro(-123764538).DollarsAndMicros()
+	suite.Equal(int64(-123), dollars)
+	suite.Equal(int64(-764538), micros)
+
+	dollars, micros = Micro(-500000).DollarsAndMicros()
+	suite.Equal(int64(0), dollars)
+	suite.Equal(int64(-500000), micros)
+
+	dollars, micros = Micro(999999).DollarsAndMicros()
+	suite.Equal(int64(0), dollars)
+	suite.Equal(int64(999999), micros)
+
+	dollars, micros = Micro(8 * Dollar).DollarsAndMicros()
+	suite.Equal(int64(8), dollars)
+	suite.Equal(int64(0), micros)
+}
+
+func (suite *MoneyTestSuite) TestEqualString() {
+	equal, err := EqualString(801*Cent, "8.01")
+	suite.Nil(err)
+	suite.True(equal)
+
+	equal, err = EqualString(801*Cent, "+008.0100")
+	suite.Nil(err)
+	suite.True(equal)
+
+	equal, err = EqualString(0, "-0")
+	suite.Nil(err)
+	suite.True(equal)
+
+	equal, err = EqualString(801*Cent, "8.02")
+	suite.Nil(err)
+	suite.False(equal)
+
+	equal, err = EqualString(801*Cent, "-8.01")
+	suite.Nil(err)
+	suite.False(equal)
+
+	equal, err = EqualString(801*Cent, "8.01x")
+	suite.Equal(ErrInvalidInput, err)
+	suite.False(equal)
+
+	equal, err = EqualString(0, "")
+	suite.Equal(ErrEmptyInput, err)
+	suite.False(equal)
+}
+
+func (suite *MoneyTestSuite) TestComparisons() {
+	balance := Micro(10 * Dollar)
+
+	suite.True(balance.Equal(10 * Dollar))
+	suite.False(balance.Equal(10*Dollar + 1))
+
+	suite.True(balance.LessThan(10*Dollar + 1))
+	suite.False(balance.LessThan(10 * Dollar))
+	suite.False(balance.LessThan(-10 * Dollar))
+
+	suite.True(balance.GreaterThan(-10 * Dollar))
+	suite.False(balance.GreaterThan(10 * Dollar))
+	suite.False(balance.GreaterThan(MaxMicro))
+
+	suite.True(Micro(MinMicro).LessThan(MaxMicro))
+	suite.True(Micro(MaxMicro).GreaterThan(MinMicro))
+}
+
+func (suite *MoneyTestSuite) TestBetween() {
+	suite.True(Micro(5*Dollar).Between(Dollar, 10*Dollar))
+	suite.True(Dollar.Between(Dollar, 10*Dollar))
+	suite.True(Micro(10*Dollar).Between(Dollar, 10*Dollar))
+	suite.True(Dollar.Between(Dollar, Dollar))
+	suite.False(Micro(Dollar-1).Between(Dollar, 10*Dollar))
+	suite.False(Micro(10*Dollar+1).Between(Dollar, 10*Dollar))
+	suite.True(Micro(-5).Between(-10, -5))
+	suite.False(Micro(5*Dollar).Between(10*Dollar, Dollar))
+}
+
+func (suite *MoneyTestSuite) TestInRange() {
+	result, err := Dollar.InRange(Dollar, 10*Dollar)
+	suite.Nil(err)
+	suite.True(result)
+
+	result, err = Micro(10*Dollar).InRange(Dollar, 10*Dollar)
+	suite.Nil(err)
+	suite.True(result)
+
+	result, err = Micro(Dollar-1).InRange(Dollar, 10*Dollar)
+	suite.Nil(err)
+	suite.False(result)
+
+	result, err = Micro(5*Dollar).InRange(10*Dollar, Dollar)
+	suite.Equal(ErrInvalidInput, err)
+	suite.False(result)
+}
+
+func (suite *MoneyTestSuite) TestRange() {
+	budget := Range{Min: 10 * Dollar, Max: 100 * Dollar}
+	suite.True(budget.Valid())
+
+	suite.True(budget.Contains(10 * Dollar))
+	suite.True(budget.Contains(50 * Dollar))
+	suite.True(budget.Contains(100 * Dollar))
+	suite.False(budget.Contains(10*Dollar - 1))
+	suite.False(budget.Contains(100*Dollar + 1))
+
+	suite.Equal(10*Dollar, budget.Clamp(-5*Dollar))
+	suite.Equal(10*Dollar, budget.Clamp(10*Dollar))
+	suite.Equal(50*Dollar, budget.Clamp(50*Dollar))
+	suite.Equal(100*Dollar, budget.Clamp(100*Dollar))
+	suite.Equal(100*Dollar, budget.Clamp(MaxMicro))
+
+	single := Range{Min: 5 * Dollar, Max: 5 * Dollar}
+	suite.True(single.Valid())
+	suite.True(single.Contains(5 * Dollar))
+	suite.Equal(5*Dollar, single.Clamp(0))
+
+	invalid := Range{Min: 100 * Dollar, Max: 10 * Dollar}
+	suite.False(invalid.Valid())
+	suite.False(invalid.Contains(50 * Dollar))
+	suite.False(invalid.Contains(10 * Dollar))
+
+	suite.True(Range{}.Valid())
+	suite.True(Range{}.Contains(0))
+}
+
+func (suite *MoneyTestSuite) TestHasSubCent() {
+	suite.False(Micro(0).HasSubCent())
+	suite.False(Micro(801 * Cent).HasSubCent())
+	suite.False(Micro(-801 * Cent).HasSubCent())
+	suite.False(MaxAmount.HasSubCent())
+
+	suite.True(Micro(1).HasSubCent())
+	suite.True(Micro(-1).HasSubCent())
+	suite.True(Micro(8015000).HasSubCent())
+	suite.True(Micro(-8015000).HasSubCent())
+	suite.True(Micro(MinMicro).HasSubCent())
+}
+
+func (suite *MoneyTestSuite) TestTruncateToCent() {
+	suite.Equal(123*Cent, Micro(1234567).TruncateToCent())
+	suite.Equal(-123*Cent, Micro(-1234567).TruncateToCent())
+	suite.Equal(123*Cent, Micro(1239999).TruncateToCent())
+	suite.Equal(123*Cent, Micro(1230000).TruncateToCent())
+	suite.Equal(Micro(0), Micro(9999).TruncateToCent())
+	suite.Equal(Micro(0), Micro(-9999).TruncateToCent())
+	suite.Equal(Micro(math.MinInt64+5808), Micro(math.MinInt64).TruncateToCent())
+}
+
+func (suite *MoneyTestSuite) TestTruncateTo() {
+	result, err := Micro(1234567).TruncateTo(Dollar)
+	suite.Nil(err)
+	suite.Equal(Dollar, result)
+
+	result, err = Micro(-1999999).TruncateTo(Dollar)
+	suite.Nil(err)
+	suite.Equal(-Dollar, result)
+
+	result, err = Micro(1234567).TruncateTo(5 * Cent)
+	suite.Nil(err)
+	suite.Equal(120*Cent, result)
+
+	result, err = Micro(1234567).TruncateTo(0)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = Micro(1234567).TruncateTo(-Cent)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestScale() {
+	for _, test := range scaleTests {
+		result, err := Scale(test.amount, test.powerOfTen)
+		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %d, %d", test.amount, test.powerOfTen))
+		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %d, %d", test.amount, test.powerOfTen))
+	}
+}
+
+func (suite *MoneyTestSuite) TestRatio() {
+	result, err := Ratio(50*Dollar, 100*Dollar)
+	suite.Nil(err)
+	suite.Equal(0.5, result)
+
+	result, err = Ratio(150*Dollar, 100*Dollar)
+	suite.Nil(err)
+	suite.Equal(1.5, result)
+
+	result, err = Ratio(-25*Cent, Dollar)
+	suite.Nil(err)
+	suite.Equal(-0.25, result)
+
+	result, err = Ratio(0, Dollar)
+	suite.Nil(err)
+	suite.Equal(0.0, result)
+
+	result, err = Ratio(Dollar, 0)
+	suite.Equal(ErrZeroDivision, err)
+	suite.Equal(0.0, result)
+}
+
+func (suite *MoneyTestSuite) TestPercentChange() {
+	result, err := PercentChange(10*Dollar, 15*Dollar)
+	suite.Nil(err)
+	suite.Equal(50.0, result)
+
+	result, err = PercentChange(10*Dollar, 5*Dollar)
+	suite.Nil(err)
+	suite.Equal(-50.0, result)
+
+	result, err = PercentChange(10*Dollar, 10*Dollar)
+	suite.Nil(err)
+	suite.Equal(0.0, result)
+
+	result, err = PercentChange(10*Dollar, 0)
+	suite.Nil(err)
+	suite.Equal(-100.0, result)
+
+	// the difference is divided by the signed base, so a negative base flips the sign
+	result, err = PercentChange(-10*Dollar, -5*Dollar)
+	suite.Nil(err)
+	suite.Equal(-50.0, result)
+
+	result, err = PercentChange(3*Dollar, 4*Dollar)
+	suite.Nil(err)
+	suite.InDelta(33.333333, result, 0.000001)
+
+	result, err = PercentChange(0, 5*Dollar)
+	suite.Equal(ErrZeroDivision, err)
+	suite.Equal(0.0, result)
+
+	result, err = PercentChange(MinMicro, MaxMicro)
 	suite.Equal(ErrOverflow, err)
+	suite.Equal(0.0, result)
+}
+
+func (suite *MoneyTestSuite) TestMulMicro() {
+	result, err := MulMicro(2*Dollar, 3*Dollar, RoundingNone)
+	suite.Nil(err)
+	suite.Equal(6*Dollar, result)
+
+	result, err = MulMicro(-2*Dollar, 3*Dollar, RoundingNone)
+	suite.Nil(err)
+	suite.Equal(-6*Dollar, result)
+
+	result, err = MulMicro(150*Cent, 150*Cent, RoundingNone)
+	suite.Nil(err)
+	suite.Equal(225*Cent, result)
+
+	// 0.000001 * 0.5 = 0.0000005
+	result, err = MulMicro(Micro(1), 50*Cent, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(Micro(1), result)
+
+	result, err = MulMicro(Micro(1), 50*Cent, RoundingNone)
+	suite.Nil(err)
 	suite.Equal(Micro(0), result)
 
-	result, err = FromFloat64(9223372036854.776807)
+	result, err = MulMicro(Micro(-1), 50*Cent, RoundingFloor)
+	suite.Nil(err)
+	suite.Equal(Micro(-1), result)
+
+	// intermediate product doesn't fit into int64
+	result, err = MulMicro(9000000000*Dollar, 50*Cent, RoundingNone)
+	suite.Nil(err)
+	suite.Equal(4500000000*Dollar, result)
+
+	result, err = MulMicro(9000000000*Dollar, 2000*Dollar, RoundingNone)
 	suite.Equal(ErrOverflow, err)
 	suite.Equal(Micro(0), result)
+
+	result, err = MulMicro(Dollar, Dollar, 255)
+	suite.Equal(ErrUnsupportedRounding, err)
+	suite.Equal(Micro(0), result)
 }
 
-func (suite *MoneyTestSuite) TestParseFloatString() {
-	for _, test := range parseFloatStringTests {
-		result, err := parseFloatString(test.input)
-		suite.Equal(test.err, err, fmt.Sprintf("Input: %s", test.input))
-		suite.Equal(test.expected, result, fmt.Sprintf("Input: %s", test.input))
+func (suite *MoneyTestSuite) TestLineTotal() {
+	result, err := LineTotal(4*Dollar, MustFromString("2.5"), RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(10*Dollar, result)
+
+	result, err = LineTotal(75*Dollar, MustFromString("1.333333"), RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(MustFromString("99.999975"), result)
+
+	result, err = LineTotal(MustFromString("0.333333"), MustFromString("0.5"), RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(Micro(166667), result)
+
+	result, err = LineTotal(MustFromString("0.333333"), MustFromString("0.5"), RoundingFloor)
+	suite.Nil(err)
+	suite.Equal(Micro(166666), result)
+
+	result, err = LineTotal(-4*Dollar, 3*Dollar, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(-12*Dollar, result)
+
+	// the intermediate product of these overflows int64, but the total fits
+	result, err = LineTotal(9000000*Dollar, 1000*Dollar, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(MaxAmount, result)
+
+	result, err = LineTotal(MaxMicro, 2*Dollar, RoundingHalfAwayFromZero)
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestFromBigRat() {
+	result, err := FromBigRat(big.NewRat(1, 3), RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(Micro(333333), result)
+
+	result, err = FromBigRat(big.NewRat(2, 3), RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(Micro(666667), result)
+
+	result, err = FromBigRat(big.NewRat(2, 3), RoundingFloor)
+	suite.Nil(err)
+	suite.Equal(Micro(666666), result)
+
+	result, err = FromBigRat(big.NewRat(-2, 3), RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(Micro(-666667), result)
+
+	result, err = FromBigRat(big.NewRat(801, 100), RoundingNone)
+	suite.Nil(err)
+	suite.Equal(801*Cent, result)
+
+	// exactly MaxMicro micros still fits
+	result, err = FromBigRat(new(big.Rat).SetFrac(big.NewInt(math.MaxInt64), big.NewInt(int64(precision))), RoundingCeil)
+	suite.Nil(err)
+	suite.Equal(Micro(MaxMicro), result)
+
+	result, err = FromBigRat(new(big.Rat).SetInt64(math.MaxInt64), RoundingHalfAwayFromZero)
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromBigRat(big.NewRat(1, 3), 255)
+	suite.Equal(ErrUnsupportedRounding, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = FromBigRat(nil, RoundingHalfAwayFromZero)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestBigRat() {
+	suite.Equal("1/4", Micro(250000).BigRat().String())
+	suite.Equal("-801/100", Micro(-801*Cent).BigRat().String())
+	suite.Equal("1/1000000", Micro(1).BigRat().String())
+	suite.Equal("0/1", Micro(0).BigRat().String())
+
+	for _, amount := range []Micro{0, 1, -1, 250000, 123764538, MaxMicro, MinMicro} {
+		result, err := FromBigRat(amount.BigRat(), RoundingNone)
+		suite.Nil(err)
+		suite.Equal(amount, result, fmt.Sprintf("Input: %d", amount))
 	}
 }
 
-func (suite *MoneyTestSuite) TestAdd() {
-	for _, test := range addTests {
-		result, err := Add(test.input1, test.input2)
-		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %d, %d", test.input1, test.input2))
-		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %d, %d", test.input1, test.input2))
+func (suite *MoneyTestSuite) TestAverage() {
+	result, err := Average([]Micro{Dollar, 2 * Dollar, 3 * Dollar}, RoundingNone)
+	suite.Nil(err)
+	suite.Equal(2*Dollar, result)
+
+	// 10 / 3 = 3.333...
+	result, err = Average([]Micro{Micro(1), Micro(2), Micro(7)}, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(Micro(3), result)
+
+	// 11 / 3 = 3.666...
+	result, err = Average([]Micro{Micro(1), Micro(3), Micro(7)}, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(Micro(4), result)
+
+	result, err = Average([]Micro{Micro(1), Micro(3), Micro(7)}, RoundingNone)
+	suite.Nil(err)
+	suite.Equal(Micro(3), result)
+
+	result, err = Average([]Micro{Micro(-1), Micro(-3), Micro(-7)}, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(Micro(-4), result)
+
+	// the sum overflows int64
+	result, err = Average([]Micro{MaxMicro, MaxMicro, MaxMicro - 2}, RoundingNone)
+	suite.Nil(err)
+	suite.Equal(Micro(MaxMicro-1), result)
+
+	result, err = Average([]Micro{MinMicro, MinMicro}, RoundingNone)
+	suite.Nil(err)
+	suite.Equal(Micro(MinMicro), result)
+
+	result, err = Average([]Micro{}, RoundingNone)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = Average(nil, RoundingNone)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+
+	result, err = Average([]Micro{Dollar}, 255)
+	suite.Equal(ErrUnsupportedRounding, err)
+	suite.Equal(Micro(0), result)
+}
+
+func (suite *MoneyTestSuite) TestRound() {
+	for _, test := range roundTests {
+		result, err := Round(test.amount, test.unit, test.rounding)
+		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %d, %d, %d", test.amount, test.unit, test.rounding))
+		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %d, %d, %d", test.amount, test.unit, test.rounding))
 	}
 }
 
-func (suite *MoneyTestSuite) TestMul() {
-	for _, test := range mulTests {
-		result, err := Mul(test.input1, test.input2)
-		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %d, %d", test.input1, test.input2))
-		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %d, %d", test.input1, test.input2))
+func (suite *MoneyTestSuite) TestQuantize() {
+	for _, test := range quantizeTests {
+		result, err := Quantize(test.amount, test.unit, test.rounding)
+		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %d, %d, %d", test.amount, test.unit, test.rounding))
+		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %d, %d, %d", test.amount, test.unit, test.rounding))
 	}
 }
 
-func (suite *MoneyTestSuite) TestDiv() {
-	for _, test := range divTests {
-		result, err := Div(test.input1, test.input2, test.rounding)
-		suite.Equal(test.err, err, fmt.Sprintf("Inputs: %d, %d", test.input1, test.input2))
-		suite.Equal(test.expected, result, fmt.Sprintf("Inputs: %d, %d", test.input1, test.input2))
+func (suite *MoneyTestSuite) TestCashRound() {
+	result, err := CashRound(1234567, 5*Cent, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(125*Cent, result)
+
+	result, err = CashRound(1220000, 5*Cent, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(120*Cent, result)
+
+	result, err = CashRound(1225000, 5*Cent, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(125*Cent, result)
+
+	result, err = CashRound(-1220000, 5*Cent, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(-120*Cent, result)
+
+	// some currencies round to 10 cents instead
+	result, err = CashRound(1260000, 10*Cent, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(130*Cent, result)
+
+	result, err = CashRound(1234567, 0, RoundingHalfAwayFromZero)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), result)
+}
+
+// FuzzParseFloatString checks that the parser never panics, that it agrees with itself for strings and byte
+// slices and that every amount it accepts round-trips through ToString. Run it with
+// go test -fuzz FuzzParseFloatString.
+func FuzzParseFloatString(f *testing.F) {
+	for _, test := range parseFloatStringTests {
+		f.Add(test.input)
 	}
+	for _, test := range fromStringLenientTests {
+		f.Add(test.input)
+	}
+	f.Add("9223372036854775808123e-20")
+	f.Add("-9223372036854.775808")
+	f.Add("+.5")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		result, err := parseFloatString(input)
+
+		bytesResult, bytesErr := parseFloatString([]byte(input))
+		if result != bytesResult || err != bytesErr {
+			t.Fatalf("%q: string and []byte parsing differ: %d, %v and %d, %v", input, result, err, bytesResult, bytesErr)
+		}
+
+		if err != nil {
+			if result != 0 {
+				t.Fatalf("%q: non-zero result %d with error %v", input, result, err)
+			}
+			if !errors.Is(err, ErrInvalidInput) && err != ErrOverflow {
+				t.Fatalf("%q: unexpected error %v", input, err)
+			}
+			return
+		}
+
+		if (result < 0) != (input[0] == '-') && result != 0 {
+			t.Fatalf("%q: parsed with the wrong sign as %d", input, result)
+		}
+
+		formatted := ToString(result)
+		parsed, err := FromString(formatted)
+		if err != nil || parsed != result {
+			t.Fatalf("%q: %d formatted as %q parses as %d, %v", input, result, formatted, parsed, err)
+		}
+	})
 }
 
 func BenchmarkFromString(b *testing.B) {
@@ -527,6 +3120,17 @@ func BenchmarkFromString(b *testing.B) {
 	}
 }
 
+func BenchmarkFromBytes(b *testing.B) {
+	amount := []byte("123.52348976")
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := FromBytes(amount)
+		if err != nil {
+			b.Error(errors.New("Unsuccessful call."))
+		}
+	}
+}
+
 func BenchmarkFromStringWithExp(b *testing.B) {
 	b.StartTimer()
 	for i := 0; i < b.N; i++ {
@@ -536,3 +3140,51 @@ func BenchmarkFromStringWithExp(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkFromStringHuge(b *testing.B) {
+	amount := "1" + strings.Repeat("0", 1000000)
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := FromString(amount)
+		if err != ErrOverflow {
+			b.Error(errors.New("Unsuccessful call."))
+		}
+	}
+}
+
+func BenchmarkFromFloat64(b *testing.B) {
+	b.ReportAllocs()
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := FromFloat64(123.52348976)
+		if err != nil {
+			b.Error(errors.New("Unsuccessful call."))
+		}
+	}
+}
+
+func BenchmarkFromFloat64Tie(b *testing.B) {
+	b.ReportAllocs()
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := FromFloat64(264.1140445)
+		if err != nil {
+			b.Error(errors.New("Unsuccessful call."))
+		}
+	}
+}
+
+func BenchmarkToString(b *testing.B) {
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		ToString(Micro(123523489760))
+	}
+}
+
+func BenchmarkAppendString(b *testing.B) {
+	buffer := make([]byte, 0, 32)
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		buffer = Micro(123523489760).AppendString(buffer[:0])
+	}
+}
@@ -0,0 +1,117 @@
+package money
+
+import (
+	"errors"
+)
+
+var ErrCurrencyMismatch = errors.New("money: currency mismatch")
+
+// Currency is an ISO 4217 currency code, eg. "USD".
+type Currency string
+
+// ISO 4217 minor units for currencies that don't use two decimal places.
+var currencyDecimalPlaces = map[Currency]int{
+	"BIF": 0,
+	"CLP": 0,
+	"DJF": 0,
+	"GNF": 0,
+	"ISK": 0,
+	"JPY": 0,
+	"KMF": 0,
+	"KRW": 0,
+	"PYG": 0,
+	"RWF": 0,
+	"UGX": 0,
+	"UYI": 0,
+	"VND": 0,
+	"VUV": 0,
+	"XAF": 0,
+	"XOF": 0,
+	"XPF": 0,
+	"BHD": 3,
+	"IQD": 3,
+	"JOD": 3,
+	"KWD": 3,
+	"LYD": 3,
+	"OMR": 3,
+	"TND": 3,
+	"CLF": 4,
+	"UYW": 4,
+}
+
+// DecimalPlaces returns the number of decimal places the currency is normally written with,
+// defaulting to 2 for unknown codes.
+func (c Currency) DecimalPlaces() int {
+	if places, ok := currencyDecimalPlaces[c]; ok {
+		return places
+	}
+	return 2
+}
+
+// Money is an amount in a specific currency. Operations on two Money values fail with
+// ErrCurrencyMismatch when their currencies differ.
+type Money struct {
+	Amount   Micro
+	Currency Currency
+}
+
+// String formats the amount with the currency's decimal places followed by the code, eg. "8.01 USD".
+func (m Money) String() string {
+	amount, err := ToStringFixed(m.Amount, m.Currency.DecimalPlaces())
+	if err != nil {
+		// rounding can overflow at the very edges of the range
+		amount = ToString(m.Amount)
+	}
+	return amount + " " + string(m.Currency)
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+
+	amount, err := Add(m.Amount, other.Amount)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, Currency: m.Currency}, nil
+}
+
+func (m Money) Sub(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+
+	amount, err := Sub(m.Amount, other.Amount)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, Currency: m.Currency}, nil
+}
+
+// Cmp returns -1, 0 or 1 when m is less than, equal to or greater than other.
+func (m Money) Cmp(other Money) (int, error) {
+	if m.Currency != other.Currency {
+		return 0, ErrCurrencyMismatch
+	}
+
+	switch {
+	case m.Amount < other.Amount:
+		return -1, nil
+	case m.Amount > other.Amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// Convert converts m to currency to using rate, the amount of target currency per one unit of m's currency
+// (eg. 118*Cent for a rate of 1.18). The result is rounded to a micro with the given rounding mode. Only a result
+// that doesn't fit into Micro returns ErrOverflow.
+func (m Money) Convert(to Currency, rate Micro, rounding byte) (Money, error) {
+	amount, err := MulDiv(m.Amount, int64(rate), int64(Dollar), rounding)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, Currency: to}, nil
+}
@@ -0,0 +1,107 @@
+package money
+
+import (
+	"fmt"
+)
+
+func (suite *MoneyTestSuite) TestCurrencyDecimalPlaces() {
+	suite.Equal(0, Currency("JPY").DecimalPlaces())
+	suite.Equal(2, Currency("USD").DecimalPlaces())
+	suite.Equal(2, Currency("EUR").DecimalPlaces())
+	suite.Equal(3, Currency("BHD").DecimalPlaces())
+	suite.Equal(2, Currency("XYZ").DecimalPlaces())
+	suite.Equal(2, Currency("").DecimalPlaces())
+}
+
+func (suite *MoneyTestSuite) TestMoneyString() {
+	suite.Equal("1000 JPY", Money{Amount: 1000 * Dollar, Currency: "JPY"}.String())
+	suite.Equal("1001 JPY", Money{Amount: Micro(1000500000), Currency: "JPY"}.String())
+	suite.Equal("8.01 USD", Money{Amount: 801 * Cent, Currency: "USD"}.String())
+	suite.Equal("-8.00 USD", Money{Amount: -8 * Dollar, Currency: "USD"}.String())
+	suite.Equal("1.235 BHD", Money{Amount: Micro(1234567), Currency: "BHD"}.String())
+	suite.Equal("8.01 XYZ", Money{Amount: 801 * Cent, Currency: "XYZ"}.String())
+	suite.Equal("9223372036854.775807 USD", Money{Amount: MaxMicro, Currency: "USD"}.String())
+	suite.Equal("8.01 USD", fmt.Sprint(Money{Amount: 801 * Cent, Currency: "USD"}))
+}
+
+func (suite *MoneyTestSuite) TestMoneyAdd() {
+	result, err := Money{Amount: 5 * Dollar, Currency: "USD"}.Add(Money{Amount: 801 * Cent, Currency: "USD"})
+	suite.Nil(err)
+	suite.Equal(Money{Amount: 1301 * Cent, Currency: "USD"}, result)
+
+	result, err = Money{Amount: 5 * Dollar, Currency: "USD"}.Add(Money{Amount: -801 * Cent, Currency: "USD"})
+	suite.Nil(err)
+	suite.Equal(Money{Amount: -301 * Cent, Currency: "USD"}, result)
+
+	result, err = Money{Amount: 5 * Dollar, Currency: "USD"}.Add(Money{Amount: 801 * Cent, Currency: "EUR"})
+	suite.Equal(ErrCurrencyMismatch, err)
+	suite.Equal(Money{}, result)
+
+	result, err = Money{Amount: MaxMicro, Currency: "USD"}.Add(Money{Amount: 1, Currency: "USD"})
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Money{}, result)
+}
+
+func (suite *MoneyTestSuite) TestMoneySub() {
+	result, err := Money{Amount: 5 * Dollar, Currency: "USD"}.Sub(Money{Amount: 801 * Cent, Currency: "USD"})
+	suite.Nil(err)
+	suite.Equal(Money{Amount: -301 * Cent, Currency: "USD"}, result)
+
+	result, err = Money{Amount: 5 * Dollar, Currency: "USD"}.Sub(Money{Amount: 801 * Cent, Currency: "EUR"})
+	suite.Equal(ErrCurrencyMismatch, err)
+	suite.Equal(Money{}, result)
+
+	result, err = Money{Amount: MinMicro, Currency: "USD"}.Sub(Money{Amount: 1, Currency: "USD"})
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Money{}, result)
+}
+
+func (suite *MoneyTestSuite) TestMoneyCmp() {
+	result, err := Money{Amount: 5 * Dollar, Currency: "USD"}.Cmp(Money{Amount: 801 * Cent, Currency: "USD"})
+	suite.Nil(err)
+	suite.Equal(-1, result)
+
+	result, err = Money{Amount: 801 * Cent, Currency: "USD"}.Cmp(Money{Amount: 5 * Dollar, Currency: "USD"})
+	suite.Nil(err)
+	suite.Equal(1, result)
+
+	result, err = Money{Amount: 5 * Dollar, Currency: "USD"}.Cmp(Money{Amount: 5 * Dollar, Currency: "USD"})
+	suite.Nil(err)
+	suite.Equal(0, result)
+
+	result, err = Money{Amount: 5 * Dollar, Currency: "USD"}.Cmp(Money{Amount: 5 * Dollar, Currency: "EUR"})
+	suite.Equal(ErrCurrencyMismatch, err)
+	suite.Equal(0, result)
+}
+
+func (suite *MoneyTestSuite) TestMoneyConvert() {
+	result, err := Money{Amount: 100 * Dollar, Currency: "USD"}.Convert("EUR", 118*Cent, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(Money{Amount: 118 * Dollar, Currency: "EUR"}, result)
+
+	result, err = Money{Amount: Micro(1), Currency: "USD"}.Convert("EUR", 150*Cent, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(Money{Amount: Micro(2), Currency: "EUR"}, result)
+
+	result, err = Money{Amount: Micro(1), Currency: "USD"}.Convert("EUR", 150*Cent, RoundingNone)
+	suite.Nil(err)
+	suite.Equal(Money{Amount: Micro(1), Currency: "EUR"}, result)
+
+	result, err = Money{Amount: -100 * Dollar, Currency: "USD"}.Convert("JPY", Micro(149123456), RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(Money{Amount: Micro(-14912345600), Currency: "JPY"}, result)
+
+	// the product of 10 million dollars and the rate doesn't fit into int64, but the result does
+	result, err = Money{Amount: 10000000 * Dollar, Currency: "USD"}.Convert("EUR", 118*Cent, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(Money{Amount: 11800000 * Dollar, Currency: "EUR"}, result)
+
+	// 10 million dollars at a rate of 1 million is 10 trillion, which doesn't fit into Micro
+	result, err = Money{Amount: 10000000 * Dollar, Currency: "USD"}.Convert("XXX", 1000000*Dollar, RoundingNone)
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Money{}, result)
+
+	result, err = Money{Amount: 100 * Dollar, Currency: "USD"}.Convert("EUR", 118*Cent, 255)
+	suite.Equal(ErrUnsupportedRounding, err)
+	suite.Equal(Money{}, result)
+}
@@ -0,0 +1,35 @@
+package money
+
+import "strings"
+
+const (
+	nanoPrecisionExp = int64(9)
+	nanoPrecision    = Nano(1000000000)
+	nanosPerMicro    = 1000
+)
+
+// Nano is a fixed-point amount with 9 decimal places, for values like bid multipliers or exchange rates that
+// need more precision than Micro.
+type Nano int64
+
+// FromStringNano is FromString for Nano amounts.
+func FromStringNano(amount string) (Nano, error) {
+	result, _, err := parseDecimal(strings.TrimSpace(amount), nanoPrecisionExp)
+	return Nano(result), err
+}
+
+// ToStringNano is ToString for Nano amounts.
+func ToStringNano(amount Nano) string {
+	return string(appendDecimal(make([]byte, 0, 24), int64(amount), nanoPrecisionExp, int64(nanoPrecision)))
+}
+
+// ToNano converts the amount to Nano, returning ErrOverflow for amounts that don't fit.
+func (micro Micro) ToNano() (Nano, error) {
+	result, err := Mul(micro, nanosPerMicro)
+	return Nano(result), err
+}
+
+// ToMicro converts the amount to Micro, rounding anything below a micro with the given rounding mode.
+func (nano Nano) ToMicro(rounding byte) (Micro, error) {
+	return divideAndRound(Micro(nano), nanosPerMicro, rounding)
+}
@@ -0,0 +1,94 @@
+package money
+
+import (
+	"fmt"
+	"math"
+)
+
+type fromStringNanoTest struct {
+	input    string
+	expected Nano
+	err      error
+}
+
+var fromStringNanoTests = []fromStringNanoTest{
+	{"1", Nano(1000000000), nil},
+	{"-1.5", Nano(-1500000000), nil},
+	{"0.123456789", Nano(123456789), nil},
+	{"0.1234567894", Nano(123456789), nil},
+	{"0.1234567895", Nano(123456790), nil},
+	{"-0.000000001", Nano(-1), nil},
+	{"9223372036.854775807", Nano(math.MaxInt64), nil},
+	{"9223372036.854775808", 0, ErrOverflow},
+	{"1.2.3", 0, ErrInvalidInput},
+	{"", 0, ErrEmptyInput},
+	{" 1.5", Nano(1500000000), nil},
+	{"\t-0.000000001\n", Nano(-1), nil},
+	{"   ", 0, ErrEmptyInput},
+	{"1 .5", 0, ErrInvalidInput},
+}
+
+func (suite *MoneyTestSuite) TestFromStringNano() {
+	for _, test := range fromStringNanoTests {
+		result, err := FromStringNano(test.input)
+		suite.Equal(test.err, err, fmt.Sprintf("Input: %s", test.input))
+		suite.Equal(test.expected, result, fmt.Sprintf("Input: %s", test.input))
+	}
+}
+
+func (suite *MoneyTestSuite) TestToStringNano() {
+	suite.Equal("0", ToStringNano(0))
+	suite.Equal("1", ToStringNano(1000000000))
+	suite.Equal("-1.5", ToStringNano(-1500000000))
+	suite.Equal("0.123456789", ToStringNano(123456789))
+	suite.Equal("-0.000000001", ToStringNano(-1))
+	suite.Equal("9223372036.854775807", ToStringNano(math.MaxInt64))
+	suite.Equal("-9223372036.854775808", ToStringNano(math.MinInt64))
+}
+
+func (suite *MoneyTestSuite) TestNanoConversion() {
+	nano, err := Micro(1234567).ToNano()
+	suite.Nil(err)
+	suite.Equal(Nano(1234567000), nano)
+
+	micro, err := nano.ToMicro(RoundingNone)
+	suite.Nil(err)
+	suite.Equal(Micro(1234567), micro)
+
+	nano, err = Micro(-1234567).ToNano()
+	suite.Nil(err)
+	suite.Equal(Nano(-1234567000), nano)
+
+	micro, err = nano.ToMicro(RoundingNone)
+	suite.Nil(err)
+	suite.Equal(Micro(-1234567), micro)
+
+	micro, err = Nano(1234567500).ToMicro(RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(Micro(1234568), micro)
+
+	micro, err = Nano(-1234567500).ToMicro(RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(Micro(-1234568), micro)
+
+	micro, err = Nano(1234567001).ToMicro(RoundingCeil)
+	suite.Nil(err)
+	suite.Equal(Micro(1234568), micro)
+
+	micro, err = Nano(1234567500).ToMicro(255)
+	suite.Equal(ErrUnsupportedRounding, err)
+	suite.Equal(Micro(0), micro)
+
+	// top of the range
+	nano, err = Micro(math.MaxInt64 / 1000).ToNano()
+	suite.Nil(err)
+	suite.Equal(Nano(math.MaxInt64/1000*1000), nano)
+
+	nano, err = Micro(math.MaxInt64/1000 + 1).ToNano()
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Nano(0), nano)
+
+	nano, err = Micro(math.MinInt64).ToNano()
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Nano(0), nano)
+}
@@ -0,0 +1,51 @@
+package money
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// Scanner reads newline separated amounts from an io.Reader one at a time, in the same way as bufio.Scanner
+// reads lines. Every line is parsed with FromString.
+type Scanner struct {
+	lines *bufio.Scanner
+	line  int
+	micro Micro
+	err   error
+}
+
+func NewScanner(r io.Reader) *Scanner {
+	return &Scanner{lines: bufio.NewScanner(r)}
+}
+
+// Scan advances to the next amount, which is then available through Micro. It returns false at the end of
+// the input or on the first error, which is wrapped with the line number and returned by Err.
+func (s *Scanner) Scan() bool {
+	if s.err != nil || !s.lines.Scan() {
+		return false
+	}
+	s.line++
+
+	micro, err := FromBytes(s.lines.Bytes())
+	if err != nil {
+		s.err = fmt.Errorf("money: line %d: %w", s.line, err)
+		s.micro = 0
+		return false
+	}
+	s.micro = micro
+	return true
+}
+
+// Micro returns the amount read by the last successful call to Scan.
+func (s *Scanner) Micro() Micro {
+	return s.micro
+}
+
+// Err returns the first parsing or reading error, or nil at the end of the input.
+func (s *Scanner) Err() error {
+	if s.err != nil {
+		return s.err
+	}
+	return s.lines.Err()
+}
@@ -0,0 +1,54 @@
+package money
+
+import (
+	"errors"
+	"strings"
+	"testing/iotest"
+)
+
+func (suite *MoneyTestSuite) TestScanner() {
+	scanner := NewScanner(strings.NewReader("8.01\n-8\r\n0.000001\n"))
+
+	var result []Micro
+	for scanner.Scan() {
+		result = append(result, scanner.Micro())
+	}
+	suite.Nil(scanner.Err())
+	suite.Equal([]Micro{801 * Cent, -8 * Dollar, 1}, result)
+
+	scanner = NewScanner(strings.NewReader(""))
+	suite.False(scanner.Scan())
+	suite.Nil(scanner.Err())
+}
+
+func (suite *MoneyTestSuite) TestScannerInvalidLine() {
+	scanner := NewScanner(strings.NewReader("8.01\n8.01x\n9"))
+
+	suite.True(scanner.Scan())
+	suite.Equal(801*Cent, scanner.Micro())
+
+	suite.False(scanner.Scan())
+	suite.Equal(Micro(0), scanner.Micro())
+	suite.True(errors.Is(scanner.Err(), ErrInvalidInput))
+	suite.EqualError(scanner.Err(), "money: line 2: money: cannot convert string to money.Micro")
+
+	// scanning stops at the first error
+	suite.False(scanner.Scan())
+	suite.True(errors.Is(scanner.Err(), ErrInvalidInput))
+
+	scanner = NewScanner(strings.NewReader("8.01\n\n9"))
+	suite.True(scanner.Scan())
+	suite.False(scanner.Scan())
+	suite.True(errors.Is(scanner.Err(), ErrEmptyInput))
+}
+
+func (suite *MoneyTestSuite) TestScannerReadError() {
+	scanner := NewScanner(iotest.TimeoutReader(strings.NewReader("8.01\n")))
+
+	var result []Micro
+	for scanner.Scan() {
+		result = append(result, scanner.Micro())
+	}
+	suite.Equal([]Micro{801 * Cent}, result)
+	suite.Equal(iotest.ErrTimeout, scanner.Err())
+}
@@ -0,0 +1,29 @@
+package money
+
+import (
+	"sort"
+)
+
+// microSlice sorts amounts with plain int64 comparisons, avoiding the reflection and closure calls of
+// sort.Slice.
+type microSlice []Micro
+
+func (s microSlice) Len() int           { return len(s) }
+func (s microSlice) Less(i, j int) bool { return s[i] < s[j] }
+func (s microSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Sort sorts amounts in place in ascending order.
+func Sort(amounts []Micro) {
+	sort.Sort(microSlice(amounts))
+}
+
+// SortStable is like Sort, but keeps equal amounts in their original order. Equal amounts are
+// indistinguishable, so it only matters for callers that rely on sort.Stable semantics.
+func SortStable(amounts []Micro) {
+	sort.Stable(microSlice(amounts))
+}
+
+// SortDescending sorts amounts in place in descending order.
+func SortDescending(amounts []Micro) {
+	sort.Sort(sort.Reverse(microSlice(amounts)))
+}
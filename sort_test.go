@@ -0,0 +1,54 @@
+package money
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func (suite *MoneyTestSuite) TestSort() {
+	amounts := []Micro{5 * Dollar, -1, MaxMicro, 0, -5 * Dollar, 5 * Dollar, MinMicro, 1, -1}
+
+	Sort(amounts)
+	suite.Equal([]Micro{MinMicro, -5 * Dollar, -1, -1, 0, 1, 5 * Dollar, 5 * Dollar, MaxMicro}, amounts)
+
+	SortDescending(amounts)
+	suite.Equal([]Micro{MaxMicro, 5 * Dollar, 5 * Dollar, 1, 0, -1, -1, -5 * Dollar, MinMicro}, amounts)
+
+	SortStable(amounts)
+	suite.Equal([]Micro{MinMicro, -5 * Dollar, -1, -1, 0, 1, 5 * Dollar, 5 * Dollar, MaxMicro}, amounts)
+
+	// empty and nil slices are fine
+	Sort([]Micro{})
+	SortDescending(nil)
+	SortStable(nil)
+}
+
+func (suite *MoneyTestSuite) TestSortRandom() {
+	random := rand.New(rand.NewSource(1))
+	amounts := make([]Micro, 1000)
+	for i := range amounts {
+		amounts[i] = Micro(random.Int63n(2000) - 1000)
+	}
+
+	Sort(amounts)
+	suite.True(sort.SliceIsSorted(amounts, func(i, j int) bool { return amounts[i] < amounts[j] }))
+
+	SortDescending(amounts)
+	suite.True(sort.SliceIsSorted(amounts, func(i, j int) bool { return amounts[i] > amounts[j] }))
+}
+
+func BenchmarkSort(b *testing.B) {
+	random := rand.New(rand.NewSource(1))
+	source := make([]Micro, 10000)
+	for i := range source {
+		source[i] = Micro(random.Int63())
+	}
+	amounts := make([]Micro, len(source))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(amounts, source)
+		Sort(amounts)
+	}
+}
@@ -0,0 +1,94 @@
+package money
+
+import (
+	"database/sql/driver"
+)
+
+// Scan implements sql.Scanner. Decimal columns ([]byte or string) are parsed, int64 values are taken as raw
+// micros (not whole dollars) with FromMicros and float64 values are converted with FromFloat64.
+func (micro *Micro) Scan(src interface{}) error {
+	var result Micro
+	var err error
+
+	switch value := src.(type) {
+	case []byte:
+		result, err = FromBytes(value)
+	case string:
+		result, err = FromString(value)
+	case int64:
+		result, err = FromMicros(value)
+	case float64:
+		result, err = FromFloat64(value)
+	default:
+		return ErrInvalidInput
+	}
+
+	if err != nil {
+		return err
+	}
+	*micro = result
+	return nil
+}
+
+// Value implements driver.Valuer, storing the amount as a decimal string. Amounts outside of MinAmount and
+// MaxAmount return ErrOverBounds.
+func (micro Micro) Value() (driver.Value, error) {
+	result, err := micro.CSV()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ValueInt64 returns the amount as raw int64 micros for BIGINT columns. Scan reads such values back.
+func (micro Micro) ValueInt64() (driver.Value, error) {
+	if err := checkBounds(micro); err != nil {
+		return nil, err
+	}
+	return int64(micro), nil
+}
+
+// NullMicro represents a Micro that may be null, in the same way as sql.NullString.
+type NullMicro struct {
+	Micro Micro
+	Valid bool // Valid is true if Micro is not NULL
+}
+
+func (n *NullMicro) Scan(src interface{}) error {
+	if src == nil {
+		n.Micro, n.Valid = 0, false
+		return nil
+	}
+
+	err := n.Micro.Scan(src)
+	n.Valid = err == nil
+	return err
+}
+
+func (n NullMicro) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Micro.Value()
+}
+
+func (n NullMicro) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Micro.MarshalJSON()
+}
+
+func (n *NullMicro) UnmarshalJSON(src []byte) error {
+	if src == nil || string(src) == "null" {
+		n.Micro, n.Valid = 0, false
+		return nil
+	}
+
+	err := n.Micro.UnmarshalJSON(src)
+	if err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
@@ -0,0 +1,198 @@
+package money
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+)
+
+var _ sql.Scanner = (*Micro)(nil)
+var _ driver.Valuer = Micro(0)
+var _ sql.Scanner = (*NullMicro)(nil)
+var _ driver.Valuer = NullMicro{}
+
+func (suite *MoneyTestSuite) TestScan() {
+	m := Micro(0)
+	err := (&m).Scan([]byte("8.01"))
+	suite.Nil(err)
+	suite.Equal(801*Cent, m)
+
+	m = Micro(0)
+	err = (&m).Scan([]byte("-8.01"))
+	suite.Nil(err)
+	suite.Equal(-801*Cent, m)
+
+	m = Micro(10)
+	err = (&m).Scan([]byte("8.01x"))
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(10), m)
+
+	m = Micro(0)
+	err = (&m).Scan("1.25")
+	suite.Nil(err)
+	suite.Equal(Micro(1250000), m)
+
+	m = Micro(10)
+	err = (&m).Scan("1.2.5")
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(10), m)
+
+	m = Micro(0)
+	err = (&m).Scan(int64(8010000))
+	suite.Nil(err)
+	suite.Equal(801*Cent, m)
+
+	m = Micro(0)
+	err = (&m).Scan(int64(-1))
+	suite.Nil(err)
+	suite.Equal(Micro(-1), m)
+
+	m = Micro(0)
+	err = (&m).Scan(8.01)
+	suite.Nil(err)
+	suite.Equal(801*Cent, m)
+
+	m = Micro(0)
+	err = (&m).Scan(-0.0000025)
+	suite.Nil(err)
+	suite.Equal(Micro(-3), m)
+
+	m = Micro(10)
+	err = (&m).Scan(13849502840392485906123.764538)
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(Micro(10), m)
+
+	m = Micro(10)
+	err = (&m).Scan(nil)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(10), m)
+
+	m = Micro(10)
+	err = (&m).Scan(true)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(10), m)
+}
+
+func (suite *MoneyTestSuite) TestValue() {
+	result, err := Micro(801 * Cent).Value()
+	suite.Nil(err)
+	suite.Equal("8.01", result)
+
+	result, err = Micro(-8 * Dollar).Value()
+	suite.Nil(err)
+	suite.Equal("-8", result)
+
+	result, err = MaxAmount.Value()
+	suite.Nil(err)
+	suite.Equal("9000000000", result)
+
+	result, err = Micro(MaxAmount + 1).Value()
+	suite.True(errors.Is(err, ErrOverBounds))
+	suite.Nil(result)
+
+	result, err = Micro(MinMicro).Value()
+	suite.True(errors.Is(err, ErrOverBounds))
+	suite.Nil(result)
+
+	result, err = NullMicro{Micro: MaxMicro, Valid: true}.Value()
+	suite.True(errors.Is(err, ErrOverBounds))
+	suite.Nil(result)
+}
+
+func (suite *MoneyTestSuite) TestValueInt64() {
+	result, err := Micro(801 * Cent).ValueInt64()
+	suite.Nil(err)
+	suite.Equal(int64(8010000), result)
+
+	result, err = MinAmount.ValueInt64()
+	suite.Nil(err)
+	suite.Equal(int64(MinAmount), result)
+
+	m := Micro(0)
+	err = (&m).Scan(result)
+	suite.Nil(err)
+	suite.Equal(MinAmount, m)
+
+	result, err = Micro(MinAmount - 1).ValueInt64()
+	suite.Equal(ErrOverBounds, err)
+	suite.Nil(result)
+
+	// Scan refuses what ValueInt64 doesn't write
+	m = Micro(10)
+	err = (&m).Scan(int64(MaxAmount + 1))
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(Micro(10), m)
+
+	err = (&m).Scan(int64(MinMicro))
+	suite.Equal(ErrOverBounds, err)
+	suite.Equal(Micro(10), m)
+}
+
+func (suite *MoneyTestSuite) TestNullMicroScan() {
+	n := NullMicro{Micro: 5 * Dollar, Valid: true}
+	err := (&n).Scan(nil)
+	suite.Nil(err)
+	suite.Equal(NullMicro{}, n)
+
+	err = (&n).Scan([]byte("8.01"))
+	suite.Nil(err)
+	suite.Equal(NullMicro{Micro: 801 * Cent, Valid: true}, n)
+
+	err = (&n).Scan([]byte("8.01x"))
+	suite.Equal(ErrInvalidInput, err)
+	suite.False(n.Valid)
+}
+
+func (suite *MoneyTestSuite) TestNullMicroValue() {
+	result, err := NullMicro{}.Value()
+	suite.Nil(err)
+	suite.Nil(result)
+
+	result, err = NullMicro{Micro: 801 * Cent, Valid: true}.Value()
+	suite.Nil(err)
+	suite.Equal("8.01", result)
+
+	// round trip through both interfaces
+	for _, n := range []NullMicro{{}, {Micro: -801 * Cent, Valid: true}, {Micro: 0, Valid: true}} {
+		value, err := n.Value()
+		suite.Nil(err)
+
+		if s, ok := value.(string); ok {
+			value = []byte(s)
+		}
+
+		var result NullMicro
+		err = (&result).Scan(value)
+		suite.Nil(err)
+		suite.Equal(n, result)
+	}
+}
+
+func (suite *MoneyTestSuite) TestNullMicroJSON() {
+	result, err := json.Marshal(NullMicro{})
+	suite.Nil(err)
+	suite.Equal("null", string(result))
+
+	result, err = json.Marshal(NullMicro{Micro: 801 * Cent, Valid: true})
+	suite.Nil(err)
+	suite.Equal("8.01", string(result))
+
+	n := NullMicro{Micro: 5 * Dollar, Valid: true}
+	err = json.Unmarshal([]byte("null"), &n)
+	suite.Nil(err)
+	suite.Equal(NullMicro{}, n)
+
+	err = json.Unmarshal([]byte(`"-8.01"`), &n)
+	suite.Nil(err)
+	suite.Equal(NullMicro{Micro: -801 * Cent, Valid: true}, n)
+
+	n = NullMicro{}
+	err = json.Unmarshal([]byte("8.01x"), &n)
+	suite.NotNil(err)
+	suite.False(n.Valid)
+
+	err = (&n).UnmarshalJSON([]byte("8.01x"))
+	suite.Equal(ErrInvalidInput, err)
+	suite.False(n.Valid)
+}
@@ -0,0 +1,46 @@
+package money
+
+// SplitTaxInclusive backs the net amount and the tax out of a tax inclusive gross amount, where
+// taxRatePercent is in percent, eg. 20*Dollar for 20% VAT. The net amount is rounded with the given rounding
+// mode and the tax is the rest, so net + tax always equals gross.
+func SplitTaxInclusive(gross Micro, taxRatePercent Micro, rounding byte) (net Micro, tax Micro, err error) {
+	if taxRatePercent < 0 {
+		return 0, 0, ErrInvalidInput
+	}
+
+	divisor, err := Add(100*Dollar, taxRatePercent)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	net, err = MulDiv(gross, int64(100*Dollar), int64(divisor), rounding)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	tax, err = Sub(gross, net)
+	if err != nil {
+		return 0, 0, err
+	}
+	return net, tax, nil
+}
+
+// AddTax adds taxRatePercent percent of tax, eg. 20*Dollar for 20% VAT, to a net amount. The tax is rounded
+// with the given rounding mode and both the gross amount and the tax are returned, so that callers can record
+// the tax line on its own.
+func AddTax(net Micro, taxRatePercent Micro, rounding byte) (gross Micro, tax Micro, err error) {
+	if taxRatePercent < 0 {
+		return 0, 0, ErrInvalidInput
+	}
+
+	tax, err = MulDiv(net, int64(taxRatePercent), int64(100*Dollar), rounding)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	gross, err = Add(net, tax)
+	if err != nil {
+		return 0, 0, err
+	}
+	return gross, tax, nil
+}
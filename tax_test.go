@@ -0,0 +1,97 @@
+package money
+
+import (
+	"fmt"
+)
+
+func (suite *MoneyTestSuite) TestSplitTaxInclusive() {
+	net, tax, err := SplitTaxInclusive(120*Dollar, 20*Dollar, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(100*Dollar, net)
+	suite.Equal(20*Dollar, tax)
+
+	net, tax, err = SplitTaxInclusive(10*Dollar, 20*Dollar, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(Micro(8333333), net)
+	suite.Equal(Micro(1666667), tax)
+
+	net, tax, err = SplitTaxInclusive(10*Dollar, 20*Dollar, RoundingCeil)
+	suite.Nil(err)
+	suite.Equal(Micro(8333334), net)
+	suite.Equal(Micro(1666666), tax)
+
+	net, tax, err = SplitTaxInclusive(-10*Dollar, 20*Dollar, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(Micro(-8333333), net)
+	suite.Equal(Micro(-1666667), tax)
+
+	net, tax, err = SplitTaxInclusive(10*Dollar, 0, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(10*Dollar, net)
+	suite.Equal(Micro(0), tax)
+
+	net, tax, err = SplitTaxInclusive(10*Dollar, -Dollar, RoundingHalfAwayFromZero)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), net)
+	suite.Equal(Micro(0), tax)
+
+	net, tax, err = SplitTaxInclusive(10*Dollar, MaxMicro, RoundingHalfAwayFromZero)
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), net)
+	suite.Equal(Micro(0), tax)
+}
+
+func (suite *MoneyTestSuite) TestSplitTaxInclusiveReconciles() {
+	rates := []Micro{5 * Dollar, MustFromString("7.7"), 19 * Dollar, 20 * Dollar, MustFromString("25.5")}
+	for _, rate := range rates {
+		for gross := Micro(-1000000); gross <= 1000000; gross += 7919 {
+			net, tax, err := SplitTaxInclusive(gross, rate, RoundingHalfAwayFromZero)
+			suite.Nil(err)
+			suite.Equal(gross, net+tax, fmt.Sprintf("Inputs: %d, %d", gross, rate))
+		}
+	}
+}
+
+func (suite *MoneyTestSuite) TestAddTax() {
+	gross, tax, err := AddTax(100*Dollar, 20*Dollar, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(120*Dollar, gross)
+	suite.Equal(20*Dollar, tax)
+
+	// the tax is 1.6666666
+	gross, tax, err = AddTax(MustFromString("8.333333"), 20*Dollar, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(10*Dollar, gross)
+	suite.Equal(Micro(1666667), tax)
+
+	gross, tax, err = AddTax(MustFromString("8.333333"), 20*Dollar, RoundingFloor)
+	suite.Nil(err)
+	suite.Equal(Micro(9999999), gross)
+	suite.Equal(Micro(1666666), tax)
+
+	gross, tax, err = AddTax(-100*Dollar, 20*Dollar, RoundingHalfAwayFromZero)
+	suite.Nil(err)
+	suite.Equal(-120*Dollar, gross)
+	suite.Equal(-20*Dollar, tax)
+
+	gross, tax, err = AddTax(100*Dollar, -Dollar, RoundingHalfAwayFromZero)
+	suite.Equal(ErrInvalidInput, err)
+	suite.Equal(Micro(0), gross)
+	suite.Equal(Micro(0), tax)
+
+	gross, tax, err = AddTax(MaxMicro, 20*Dollar, RoundingHalfAwayFromZero)
+	suite.Equal(ErrOverflow, err)
+	suite.Equal(Micro(0), gross)
+	suite.Equal(Micro(0), tax)
+}
+
+func (suite *MoneyTestSuite) TestAddTaxReconciles() {
+	rates := []Micro{5 * Dollar, MustFromString("7.7"), 19 * Dollar, 20 * Dollar, MustFromString("25.5")}
+	for _, rate := range rates {
+		for net := Micro(-1000000); net <= 1000000; net += 7919 {
+			gross, tax, err := AddTax(net, rate, RoundingHalfAwayFromZero)
+			suite.Nil(err)
+			suite.Equal(gross, net+tax, fmt.Sprintf("Inputs: %d, %d", net, rate))
+		}
+	}
+}